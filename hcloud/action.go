@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -159,6 +160,18 @@ func (c *ActionClient) AllWithOpts(ctx context.Context, opts ActionListOpts) ([]
 	return c.action.All(ctx, opts)
 }
 
+// Iter returns an iterator over all actions for the given options.
+// The pages are fetched lazily while iterating.
+//
+// It is required to set [ActionListOpts.ID]. Any other fields set in the opts are ignored.
+func (c *ActionClient) Iter(ctx context.Context, opts ActionListOpts) iter.Seq2[*Action, error] {
+	if opts.ListOpts.PerPage == 0 {
+		// Do not send unused per page param
+		opts.ListOpts.PerPage = -1
+	}
+	return c.action.Iter(ctx, opts)
+}
+
 // ResourceActionClient is a client for the actions API exposed by the resource.
 type ResourceActionClient[R actionSupporter] struct {
 	resource string
@@ -213,7 +226,20 @@ func (c *ResourceActionClient[R]) All(ctx context.Context, opts ActionListOpts)
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Action, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Action, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all actions for the given options.
+// The pages are fetched lazily while iterating.
+func (c *ResourceActionClient[R]) Iter(ctx context.Context, opts ActionListOpts) iter.Seq2[*Action, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Action, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -254,7 +280,17 @@ func (c *ResourceActionClient[R]) ListFor(ctx context.Context, resource R, opts
 
 // AllFor returns all actions for the given Resource.
 func (c *ResourceActionClient[R]) AllFor(ctx context.Context, resource R, opts ActionListOpts) ([]*Action, error) {
-	return iterPages(func(page int) ([]*Action, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Action, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.ListFor(ctx, resource, opts)
+	})
+}
+
+// IterFor returns an iterator over all actions for the given Resource.
+// The pages are fetched lazily while iterating.
+func (c *ResourceActionClient[R]) IterFor(ctx context.Context, resource R, opts ActionListOpts) iter.Seq2[*Action, error] {
+	return iterPagesSeq(ctx, func(page int) ([]*Action, *Response, error) {
 		opts.Page = page
 		return c.ListFor(ctx, resource, opts)
 	})
@@ -0,0 +1,185 @@
+package hcloud
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// ActionQuery specifies the filters of [ActionClient.Query].
+//
+// Either Resource or ID must be set.
+type ActionQuery struct {
+	// Resource restricts the actions to a single resource, or to all the resources of a
+	// type when the ID of the resource is 0.
+	Resource *ActionResource
+	ID       []int64
+	Status   []ActionStatus
+	Command  []string
+
+	// StartedAfter and StartedBefore restrict the actions to those started at or after,
+	// and before the given times. Zero values are ignored.
+	StartedAfter  time.Time
+	StartedBefore time.Time
+	// FinishedAfter and FinishedBefore restrict the actions to those finished at or
+	// after, and before the given times. Zero values are ignored. Running actions are
+	// excluded when any of them is set.
+	FinishedAfter  time.Time
+	FinishedBefore time.Time
+}
+
+// Validate checks if the query is valid.
+func (q ActionQuery) Validate() error {
+	if q.Resource == nil {
+		if len(q.ID) == 0 {
+			return missingOneOfFields(q, "Resource", "ID")
+		}
+		return nil
+	}
+	if q.Resource.Type == "" {
+		return missingField(q, "Resource.Type")
+	}
+	if _, ok := actionResourcePaths[q.Resource.Type]; !ok {
+		return invalidFieldValue(q, "Resource.Type", q.Resource.Type)
+	}
+	return nil
+}
+
+// matches returns whether the action matches the filters that are not supported by the
+// API.
+func (q ActionQuery) matches(action *Action) bool {
+	if len(q.Command) > 0 && !slices.Contains(q.Command, action.Command) {
+		return false
+	}
+	if !inTimeRange(action.Started, q.StartedAfter, q.StartedBefore) {
+		return false
+	}
+	if !q.FinishedAfter.IsZero() || !q.FinishedBefore.IsZero() {
+		if action.Finished.IsZero() || !inTimeRange(action.Finished, q.FinishedAfter, q.FinishedBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+func inTimeRange(t, after, before time.Time) bool {
+	if !after.IsZero() && t.Before(after) {
+		return false
+	}
+	if !before.IsZero() && !t.Before(before) {
+		return false
+	}
+	return true
+}
+
+// actionResourcePaths maps the action resource types to the path of their actions API.
+var actionResourcePaths = map[ActionResourceType]string{
+	ActionResourceTypeCertificate:  "certificates",
+	ActionResourceTypeFirewall:     "firewalls",
+	ActionResourceTypeFloatingIP:   "floating_ips",
+	ActionResourceTypeImage:        "images",
+	ActionResourceTypeLoadBalancer: "load_balancers",
+	ActionResourceTypeNetwork:      "networks",
+	ActionResourceTypePrimaryIP:    "primary_ips",
+	ActionResourceTypeServer:       "servers",
+	ActionResourceTypeStorageBox:   "storage_boxes",
+	ActionResourceTypeVolume:       "volumes",
+	ActionResourceTypeZone:         "zones",
+}
+
+// actionResourceID references a resource by its ID in the actions API.
+type actionResourceID int64
+
+func (id actionResourceID) pathID() (string, error) {
+	return strconv.FormatInt(int64(id), 10), nil
+}
+
+// Query returns an iterator over the actions matching the query. The pages are fetched
+// lazily while iterating.
+//
+// The actions are returned from the most to the least recently started, and the
+// iteration stops at the first action started before [ActionQuery.StartedAfter].
+// The filters by command and time range are applied on the client side.
+func (c *ActionClient) Query(ctx context.Context, query ActionQuery) iter.Seq2[*Action, error] {
+	return func(yield func(*Action, error) bool) {
+		if err := query.Validate(); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		opts := ActionListOpts{
+			ListOpts: ListOpts{PerPage: 50},
+			ID:       query.ID,
+			Status:   query.Status,
+			Sort:     []string{"started:desc"},
+		}
+
+		var actions iter.Seq2[*Action, error]
+		if query.Resource == nil {
+			actions = c.Iter(ctx, opts)
+		} else {
+			client := c.action.client
+			if query.Resource.Type == ActionResourceTypeStorageBox {
+				// The storage boxes are served by the Hetzner API.
+				client = client.StorageBox.client
+			}
+			resourceClient := &ResourceActionClient[actionResourceID]{
+				client:   client,
+				resource: actionResourcePaths[query.Resource.Type],
+			}
+
+			if query.Resource.ID == 0 {
+				actions = resourceClient.Iter(ctx, opts)
+			} else {
+				actions = resourceClient.IterFor(ctx, actionResourceID(query.Resource.ID), opts)
+			}
+		}
+
+		for action, err := range actions {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !query.StartedAfter.IsZero() && action.Started.Before(query.StartedAfter) {
+				return
+			}
+			if !query.matches(action) {
+				continue
+			}
+			if !yield(action, nil) {
+				return
+			}
+		}
+	}
+}
+
+// WriteActionsJSONL writes the actions to w as JSON lines, one action per line, using
+// the representation of the API. It returns the number of written actions, and stops
+// at the first error.
+//
+// For example, to export the actions of a server in the last 24 hours:
+//
+//	actions := client.Action.Query(ctx, hcloud.ActionQuery{
+//		Resource:     &hcloud.ActionResource{Type: hcloud.ActionResourceTypeServer, ID: server.ID},
+//		StartedAfter: time.Now().Add(-24 * time.Hour),
+//	})
+//	count, err := hcloud.WriteActionsJSONL(os.Stdout, actions)
+func WriteActionsJSONL(w io.Writer, actions iter.Seq2[*Action, error]) (int, error) {
+	encoder := json.NewEncoder(w)
+
+	count := 0
+	for action, err := range actions {
+		if err != nil {
+			return count, err
+		}
+		if err := encoder.Encode(SchemaFromAction(action)); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,156 @@
+package hcloud
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func collectActions(t *testing.T, actions func(func(*Action, error) bool)) []int64 {
+	t.Helper()
+
+	ids := make([]int64, 0)
+	for action, err := range actions {
+		require.NoError(t, err)
+		ids = append(ids, action.ID)
+	}
+	return ids
+}
+
+func TestActionClientQuery(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	finished := func(d time.Duration) *time.Time { return Ptr(now.Add(d)) }
+
+	t.Run("resource", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/servers/42/actions?page=1&per_page=50&sort=started%3Adesc&status=success",
+				Status: 200,
+				JSON: map[string]any{
+					"actions": []schema.Action{
+						{ID: 5, Command: "poweron", Status: "success", Started: now.Add(-1 * time.Hour), Finished: finished(-59 * time.Minute)},
+						{ID: 4, Command: "poweroff", Status: "success", Started: now.Add(-2 * time.Hour), Finished: finished(-119 * time.Minute)},
+						{ID: 3, Command: "poweron", Status: "success", Started: now.Add(-3 * time.Hour), Finished: finished(-179 * time.Minute)},
+					},
+					// The next page is not fetched, as its actions started earlier.
+					"meta": schema.Meta{Pagination: &schema.MetaPagination{Page: 1, NextPage: 2, LastPage: 2}},
+				},
+			},
+		})
+
+		actions := client.Action.Query(ctx, ActionQuery{
+			Resource:     &ActionResource{Type: ActionResourceTypeServer, ID: 42},
+			Status:       []ActionStatus{ActionStatusSuccess},
+			Command:      []string{"poweron"},
+			StartedAfter: now.Add(-150 * time.Minute),
+		})
+		assert.Equal(t, []int64{5}, collectActions(t, actions))
+	})
+
+	t.Run("resource type", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/storage_boxes/actions?page=1&per_page=50&sort=started%3Adesc",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{ID: 3, Status: "running", Started: now.Add(-1 * time.Minute)},
+						{ID: 2, Status: "success", Started: now.Add(-2 * time.Hour), Finished: finished(-90 * time.Minute)},
+						{ID: 1, Status: "success", Started: now.Add(-3 * time.Hour), Finished: finished(-150 * time.Minute)},
+					},
+				},
+			},
+		})
+
+		actions := client.Action.Query(ctx, ActionQuery{
+			Resource:       &ActionResource{Type: ActionResourceTypeStorageBox},
+			FinishedBefore: now.Add(-100 * time.Minute),
+		})
+		assert.Equal(t, []int64{1}, collectActions(t, actions))
+	})
+
+	t.Run("ids", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/actions?id=1&id=2&id=3&page=1&per_page=50&sort=started%3Adesc",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{ID: 3, Started: now.Add(-1 * time.Hour)},
+						{ID: 2, Started: now.Add(-2 * time.Hour)},
+						{ID: 1, Started: now.Add(-4 * time.Hour)},
+					},
+				},
+			},
+		})
+
+		actions := client.Action.Query(ctx, ActionQuery{
+			ID:           []int64{1, 2, 3},
+			StartedAfter: now.Add(-3 * time.Hour),
+		})
+		assert.Equal(t, []int64{3, 2}, collectActions(t, actions))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		ctx, _, client := makeTestUtils(t)
+
+		for _, err := range client.Action.Query(ctx, ActionQuery{}) {
+			require.EqualError(t, err, "missing one of fields [Resource, ID] in [hcloud.ActionQuery]")
+		}
+		for _, err := range client.Action.Query(ctx, ActionQuery{Resource: &ActionResource{Type: ActionResourceTypePlacementGroup}}) {
+			require.EqualError(t, err, "invalid value 'placement_group' for field [Resource.Type] in [hcloud.ActionQuery]")
+		}
+	})
+}
+
+func TestWriteActionsJSONL(t *testing.T) {
+	started := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	actions := []*Action{
+		{ID: 1, Command: "create_server", Status: ActionStatusRunning, Started: started, Resources: []*ActionResource{{ID: 42, Type: ActionResourceTypeServer}}},
+		{ID: 2, Command: "delete_server", Status: ActionStatusError, Started: started, ErrorCode: "action_failed", ErrorMessage: "Action failed"},
+	}
+
+	t.Run("succeed", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		count, err := WriteActionsJSONL(buf, func(yield func(*Action, error) bool) {
+			for _, action := range actions {
+				if !yield(action, nil) {
+					return
+				}
+			}
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t,
+			`{"id":1,"status":"running","command":"create_server","progress":0,"started":"2026-10-17T12:00:00Z","finished":null,"error":null,"resources":[{"id":42,"type":"server"}]}`+"\n"+
+				`{"id":2,"status":"error","command":"delete_server","progress":0,"started":"2026-10-17T12:00:00Z","finished":null,"error":{"code":"action_failed","message":"Action failed"},"resources":null}`+"\n",
+			buf.String(),
+		)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		count, err := WriteActionsJSONL(buf, func(yield func(*Action, error) bool) {
+			if yield(actions[0], nil) {
+				yield(nil, errors.New("failure"))
+			}
+		})
+		require.EqualError(t, err, "failure")
+		assert.Equal(t, 1, count)
+		assert.Equal(t, 1, bytes.Count(buf.Bytes(), []byte("\n")))
+	})
+}
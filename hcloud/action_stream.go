@@ -0,0 +1,131 @@
+package hcloud
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"time"
+)
+
+// ActionUpdate describes a change of an action watched by [ActionClient.Updates].
+type ActionUpdate struct {
+	// Action is the updated action.
+	Action *Action
+	// PreviousStatus is the status of the action before the update.
+	PreviousStatus ActionStatus
+	// ProgressDelta is the progress made by the action since the previous update. A
+	// completed action is considered to have a progress of 100.
+	ProgressDelta int
+	// OverallProgress is the average progress of all the watched actions, between 0
+	// and 100.
+	OverallProgress int
+	// Elapsed is the time elapsed since the watch started.
+	Elapsed time.Duration
+}
+
+// errStopUpdates stops waiting for the actions when the consumer stops iterating.
+var errStopUpdates = errors.New("stop updates")
+
+// Updates returns an iterator over the updates of the actions, until all actions are
+// completed. The actions are polled using [ActionClient.WaitForFunc].
+//
+// Each action is yielded once when first seen, and then whenever its status or progress
+// changes. A failed action does not stop the iteration, its error is available using
+// [Action.Error] on the update. An error is only yielded when waiting for the actions
+// failed, for example when the context is canceled, and ends the iteration.
+func (c *ActionClient) Updates(ctx context.Context, actions ...*Action) iter.Seq2[ActionUpdate, error] {
+	return func(yield func(ActionUpdate, error) bool) {
+		actions := slices.DeleteFunc(slices.Clone(actions), func(a *Action) bool { return a == nil })
+		if len(actions) == 0 {
+			return
+		}
+
+		start := time.Now()
+
+		previous := make(map[int64]*Action, len(actions))
+		progress := make(map[int64]int, len(actions))
+		for _, action := range actions {
+			previous[action.ID] = action
+			progress[action.ID] = actionProgress(action)
+		}
+		reported := make(map[int64]struct{}, len(actions))
+
+		err := c.WaitForFunc(ctx, func(update *Action) error {
+			prev := previous[update.ID]
+			delta := actionProgress(update) - progress[update.ID]
+
+			_, seen := reported[update.ID]
+			if seen && delta == 0 && update.Status == prev.Status {
+				return nil
+			}
+			reported[update.ID] = struct{}{}
+			previous[update.ID] = update
+			progress[update.ID] = actionProgress(update)
+
+			sum := 0
+			for _, p := range progress {
+				sum += p
+			}
+
+			if !yield(ActionUpdate{
+				Action:          update,
+				PreviousStatus:  prev.Status,
+				ProgressDelta:   delta,
+				OverallProgress: sum / len(progress),
+				Elapsed:         time.Since(start),
+			}, nil) {
+				return errStopUpdates
+			}
+			return nil
+		}, actions...)
+		if err != nil && !errors.Is(err, errStopUpdates) {
+			yield(ActionUpdate{}, err)
+		}
+	}
+}
+
+// WatchUpdates watches the updates of the actions in a goroutine, until all actions are
+// completed. This is the channel based variant of [ActionClient.Updates]:
+//
+//   - The first channel receives the updates of the actions. Unlike
+//     [ActionClient.WatchProgress], no update is dropped, the channel must therefore be
+//     drained.
+//   - The second channel receives the error that stopped the watch, if any.
+//
+// To stop watching, cancel the [context.Context]. Once the method has stopped watching,
+// both returned channels are closed.
+func (c *ActionClient) WatchUpdates(ctx context.Context, actions ...*Action) (<-chan ActionUpdate, <-chan error) {
+	errCh := make(chan error, 1)
+	updateCh := make(chan ActionUpdate)
+
+	go func() {
+		defer close(errCh)
+		defer close(updateCh)
+
+		for update, err := range c.Updates(ctx, actions...) {
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			select {
+			case updateCh <- update:
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return updateCh, errCh
+}
+
+// actionProgress returns the progress of the action, completed actions have a progress
+// of 100.
+func actionProgress(action *Action) int {
+	if action.Status != ActionStatusRunning {
+		return 100
+	}
+	return action.Progress
+}
@@ -0,0 +1,178 @@
+package hcloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestActionClientUpdates(t *testing.T) {
+	t.Run("succeed", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/actions?id=1&id=2&page=1&sort=status&sort=id",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{ID: 1, Command: "create_server", Status: "running", Progress: 20},
+						{ID: 2, Command: "start_server", Status: "running", Progress: 0},
+					},
+				},
+			},
+			{
+				Method: "GET", Path: "/actions?id=1&id=2&page=1&sort=status&sort=id",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{ID: 1, Command: "create_server", Status: "success", Progress: 100},
+						{ID: 2, Command: "start_server", Status: "running", Progress: 0},
+					},
+				},
+			},
+			{
+				Method: "GET", Path: "/actions?id=2&page=1&sort=status&sort=id",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{
+							ID: 2, Command: "start_server", Status: "error", Progress: 100,
+							Error: &schema.ActionError{Code: "action_failed", Message: "Action failed"},
+						},
+					},
+				},
+			},
+		})
+
+		updates := make([]ActionUpdate, 0)
+		for update, err := range client.Action.Updates(ctx,
+			&Action{ID: 1, Status: ActionStatusRunning},
+			&Action{ID: 2, Status: ActionStatusRunning},
+		) {
+			require.NoError(t, err)
+			updates = append(updates, update)
+		}
+
+		require.Len(t, updates, 4)
+
+		type summary struct {
+			ID              int64
+			Status          ActionStatus
+			PreviousStatus  ActionStatus
+			ProgressDelta   int
+			OverallProgress int
+		}
+		summaries := make([]summary, 0, len(updates))
+		for _, u := range updates {
+			summaries = append(summaries, summary{u.Action.ID, u.Action.Status, u.PreviousStatus, u.ProgressDelta, u.OverallProgress})
+		}
+		assert.Equal(t, []summary{
+			{1, ActionStatusRunning, ActionStatusRunning, 20, 10},
+			{2, ActionStatusRunning, ActionStatusRunning, 0, 10},
+			{1, ActionStatusSuccess, ActionStatusRunning, 80, 50},
+			{2, ActionStatusError, ActionStatusRunning, 100, 100},
+		}, summaries)
+		assert.EqualError(t, updates[3].Action.Error(), "Action failed (action_failed, 2)")
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		ctx, _, client := makeTestUtils(t)
+
+		updates := make([]ActionUpdate, 0)
+		for update, err := range client.Action.Updates(ctx, &Action{ID: 1, Status: ActionStatusSuccess}, nil) {
+			require.NoError(t, err)
+			updates = append(updates, update)
+		}
+
+		require.Len(t, updates, 1)
+		assert.Equal(t, ActionStatusSuccess, updates[0].PreviousStatus)
+		assert.Equal(t, 0, updates[0].ProgressDelta)
+		assert.Equal(t, 100, updates[0].OverallProgress)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/actions?id=1&page=1&sort=status&sort=id",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{{ID: 1, Status: "running", Progress: 20}},
+				},
+			},
+		})
+
+		count := 0
+		for _, err := range client.Action.Updates(ctx, &Action{ID: 1, Status: ActionStatusRunning}) {
+			require.NoError(t, err)
+			count++
+			break
+		}
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/actions?id=1&page=1&sort=status&sort=id",
+				Status: 503,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: "service_error", Message: "Service error"}},
+			},
+		})
+
+		for _, err := range client.Action.Updates(ctx, &Action{ID: 1, Status: ActionStatusRunning}) {
+			require.EqualError(t, err, "Service error (service_error)")
+		}
+	})
+}
+
+func TestActionClientWatchUpdates(t *testing.T) {
+	t.Run("succeed", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/actions?id=1&page=1&sort=status&sort=id",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{{ID: 1, Status: "success", Progress: 100}},
+				},
+			},
+		})
+
+		updateCh, errCh := client.Action.WatchUpdates(ctx, &Action{ID: 1, Status: ActionStatusRunning})
+
+		updates := make([]ActionUpdate, 0)
+		for update := range updateCh {
+			updates = append(updates, update)
+		}
+		require.NoError(t, <-errCh)
+
+		require.Len(t, updates, 1)
+		assert.Equal(t, ActionStatusSuccess, updates[0].Action.Status)
+		assert.Equal(t, 100, updates[0].ProgressDelta)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		_, _, client := makeTestUtils(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		updateCh, errCh := client.Action.WatchUpdates(ctx, &Action{ID: 1, Status: ActionStatusSuccess})
+
+		// The update is never received.
+		cancel()
+
+		require.ErrorIs(t, <-errCh, context.Canceled)
+		_, ok := <-updateCh
+		assert.False(t, ok)
+	})
+}
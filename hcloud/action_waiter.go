@@ -2,10 +2,15 @@ package hcloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"slices"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ActionWaiter interface {
@@ -20,12 +25,30 @@ var _ ActionWaiter = (*ActionClient)(nil)
 // either [ActionStatusSuccess] or [ActionStatusError].
 //
 // The handleUpdate callback is called every time an action is updated.
-func (c *ActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update *Action) error, actions ...*Action) error {
+func (c *ActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update *Action) error, actions ...*Action) (err error) {
 	// Filter out nil actions
 	actions = slices.DeleteFunc(actions, func(a *Action) bool { return a == nil })
 
+	actionIDs := make([]int64, 0, len(actions))
+	for _, action := range actions {
+		actionIDs = append(actionIDs, action.ID)
+	}
+
+	ctx, span := c.action.client.tracer.Start(ctx, "hcloud.action.wait",
+		trace.WithAttributes(attribute.Int64Slice("hcloud.action.ids", actionIDs)),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	statuses := make(map[int64]ActionStatus, len(actions))
 	running := make(map[int64]struct{}, len(actions))
 	for _, action := range actions {
+		statuses[action.ID] = action.Status
 		if action.Status == ActionStatusRunning {
 			running[action.ID] = struct{}{}
 		} else if handleUpdate != nil {
@@ -38,45 +61,31 @@ func (c *ActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update
 		}
 	}
 
-	retries := 0
-	for len(running) != 0 {
-
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("%w: remaining running actions: %v", ctx.Err(), slices.Collect(maps.Keys(running)))
-		case <-time.After(c.action.client.pollBackoffFunc(retries)):
-			retries++
-		}
-
-		updates := make([]*Action, 0, len(running))
-		for runningIDsChunk := range slices.Chunk(slices.Sorted(maps.Keys(running)), 25) {
-			opts := ActionListOpts{
-				Sort: []string{"status", "id"},
-				ID:   runningIDsChunk,
+	var next func(ctx context.Context) ([]*Action, error)
+	if watcher := c.action.client.actionWatcher; watcher != nil && len(running) != 0 {
+		sub := watcher.subscribe(ctx, slices.Sorted(maps.Keys(running)))
+		defer sub.close()
+		next = sub.next
+	} else {
+		retries := 0
+		next = func(ctx context.Context) ([]*Action, error) {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(c.action.client.pollBackoffFunc(retries)):
+				retries++
 			}
-
-			updatesChunk, err := c.AllWithOpts(ctx, opts)
-			if err != nil {
-				return err
-			}
-
-			updates = append(updates, updatesChunk...)
+			return c.poll(ctx, running)
 		}
+	}
 
-		if len(updates) != len(running) {
-			// Some actions may not exist in the API, also fail early to prevent an
-			// infinite loop when updates == 0.
-
-			notFound := maps.Clone(running)
-			for _, update := range updates {
-				delete(notFound, update.ID)
-			}
-			notFoundIDs := make([]int64, 0, len(notFound))
-			for unknownID := range notFound {
-				notFoundIDs = append(notFoundIDs, unknownID)
+	for len(running) != 0 {
+		updates, err := next(ctx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+				return fmt.Errorf("%w: remaining running actions: %v", ctxErr, slices.Collect(maps.Keys(running)))
 			}
-
-			return fmt.Errorf("actions not found: %v", notFoundIDs)
+			return err
 		}
 
 		for _, update := range updates {
@@ -84,6 +93,16 @@ func (c *ActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update
 				delete(running, update.ID)
 			}
 
+			if update.Status != statuses[update.ID] {
+				span.AddEvent("action status changed", trace.WithAttributes(
+					attribute.Int64("hcloud.action.id", update.ID),
+					attribute.String("hcloud.action.command", update.Command),
+					attribute.String("hcloud.action.status", string(update.Status)),
+					attribute.String("hcloud.action.previous_status", string(statuses[update.ID])),
+				))
+				statuses[update.ID] = update.Status
+			}
+
 			if handleUpdate != nil {
 				if err := handleUpdate(update); err != nil {
 					return err
@@ -95,6 +114,42 @@ func (c *ActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update
 	return nil
 }
 
+// poll fetches the running actions.
+func (c *ActionClient) poll(ctx context.Context, running map[int64]struct{}) ([]*Action, error) {
+	updates := make([]*Action, 0, len(running))
+	for runningIDsChunk := range slices.Chunk(slices.Sorted(maps.Keys(running)), 25) {
+		opts := ActionListOpts{
+			Sort: []string{"status", "id"},
+			ID:   runningIDsChunk,
+		}
+
+		updatesChunk, err := c.AllWithOpts(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, updatesChunk...)
+	}
+
+	if len(updates) != len(running) {
+		// Some actions may not exist in the API, also fail early to prevent an
+		// infinite loop when updates == 0.
+
+		notFound := maps.Clone(running)
+		for _, update := range updates {
+			delete(notFound, update.ID)
+		}
+		notFoundIDs := make([]int64, 0, len(notFound))
+		for unknownID := range notFound {
+			notFoundIDs = append(notFoundIDs, unknownID)
+		}
+
+		return nil, fmt.Errorf("actions not found: %v", notFoundIDs)
+	}
+
+	return updates, nil
+}
+
 // WaitFor waits until all actions succeed by polling the API at the interval defined by
 // [WithPollOpts]. An action is considered as succeeded when its status is either
 // [ActionStatusSuccess].
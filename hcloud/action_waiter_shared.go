@@ -0,0 +1,235 @@
+package hcloud
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// actionWatcher polls the actions awaited by all the concurrent [ActionClient.WaitForFunc]
+// calls of a Client, and fans the updates out to the subscriptions of the calls.
+//
+// The watcher polls the API as long as there are subscriptions, using the poll backoff
+// function of the Client, which is restarted when new actions are awaited. The actions
+// are fetched using list requests of up to 25 actions.
+type actionWatcher struct {
+	client *Client
+
+	mu     sync.Mutex
+	subs   map[int64][]*actionSubscription
+	cancel context.CancelFunc
+	// reset restarts the poll backoff when new actions are awaited.
+	reset chan struct{}
+}
+
+func newActionWatcher(client *Client) *actionWatcher {
+	return &actionWatcher{
+		client: client,
+		subs:   make(map[int64][]*actionSubscription),
+		reset:  make(chan struct{}, 1),
+	}
+}
+
+// subscribe returns a subscription that receives the updates of the actions, and starts
+// polling the API if needed. The subscription must be closed when no longer used.
+//
+// The API is polled using the values of the context of the subscription starting the
+// watcher, e.g. to trace the requests in its span.
+func (w *actionWatcher) subscribe(ctx context.Context, ids []int64) *actionSubscription {
+	sub := &actionSubscription{watcher: w, ids: ids, notify: make(chan struct{}, 1)}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	added := false
+	for _, id := range ids {
+		if _, ok := w.subs[id]; !ok {
+			added = true
+		}
+		w.subs[id] = append(w.subs[id], sub)
+	}
+
+	switch {
+	case w.cancel == nil && len(w.subs) > 0:
+		var runCtx context.Context
+		runCtx, w.cancel = context.WithCancel(context.WithoutCancel(ctx))
+		go w.run(runCtx)
+	case w.cancel != nil && added:
+		// The new actions must not wait for the backoff reached by the previous ones.
+		select {
+		case w.reset <- struct{}{}:
+		default:
+		}
+	}
+
+	return sub
+}
+
+// unsubscribe removes the subscription, and stops polling the API when no subscription
+// remains.
+func (w *actionWatcher) unsubscribe(sub *actionSubscription) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, id := range sub.ids {
+		subs := slices.DeleteFunc(w.subs[id], func(s *actionSubscription) bool { return s == sub })
+		if len(subs) == 0 {
+			delete(w.subs, id)
+		} else {
+			w.subs[id] = subs
+		}
+	}
+
+	w.stopIfIdle()
+}
+
+// stopIfIdle stops polling the API when no action is awaited. Must be called with the
+// lock held.
+func (w *actionWatcher) stopIfIdle() {
+	if len(w.subs) == 0 && w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+}
+
+func (w *actionWatcher) run(ctx context.Context) {
+	retries := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.reset:
+			retries = 0
+			continue
+		case <-time.After(w.client.pollBackoffFunc(retries)):
+			retries++
+		}
+
+		w.mu.Lock()
+		ids := slices.Sorted(maps.Keys(w.subs))
+		w.mu.Unlock()
+
+		for idsChunk := range slices.Chunk(ids, 25) {
+			opts := ActionListOpts{
+				Sort: []string{"status", "id"},
+				ID:   idsChunk,
+			}
+
+			updates, err := w.client.Action.AllWithOpts(ctx, opts)
+			if !w.dispatch(ctx, idsChunk, updates, err) {
+				return
+			}
+		}
+	}
+}
+
+// dispatch sends the updates of the requested actions to their subscriptions. Completed
+// and unknown actions are no longer polled. Returns false if the watcher was stopped.
+func (w *actionWatcher) dispatch(ctx context.Context, ids []int64, updates []*Action, err error) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// The subscriptions of a stopped watcher may belong to a new watcher run.
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		for _, id := range ids {
+			for _, sub := range w.subs[id] {
+				sub.push(nil, err)
+			}
+		}
+		return true
+	}
+
+	notFound := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		notFound[id] = struct{}{}
+	}
+
+	for _, update := range updates {
+		delete(notFound, update.ID)
+		for _, sub := range w.subs[update.ID] {
+			// Each subscription receives its own copy, the updates may be modified by
+			// the callers.
+			update := *update
+			sub.push(&update, nil)
+		}
+		if update.Status != ActionStatusRunning {
+			delete(w.subs, update.ID)
+		}
+	}
+
+	if len(notFound) > 0 {
+		// Some actions may not exist in the API, fail early to prevent an infinite loop.
+		err := fmt.Errorf("actions not found: %v", slices.Sorted(maps.Keys(notFound)))
+		for id := range notFound {
+			for _, sub := range w.subs[id] {
+				sub.push(nil, err)
+			}
+			delete(w.subs, id)
+		}
+	}
+
+	w.stopIfIdle()
+	return w.cancel != nil
+}
+
+// actionSubscription receives the updates of the actions awaited by a single
+// [ActionClient.WaitForFunc] call.
+type actionSubscription struct {
+	watcher *actionWatcher
+	ids     []int64
+
+	mu      sync.Mutex
+	updates []*Action
+	err     error
+	notify  chan struct{}
+}
+
+func (s *actionSubscription) push(update *Action, err error) {
+	s.mu.Lock()
+	if update != nil {
+		s.updates = append(s.updates, update)
+	}
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// next waits for the next updates of the actions, or returns the error of the watcher.
+func (s *actionSubscription) next(ctx context.Context) ([]*Action, error) {
+	for {
+		s.mu.Lock()
+		updates, err := s.updates, s.err
+		s.updates = nil
+		s.mu.Unlock()
+
+		if len(updates) > 0 {
+			return updates, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.notify:
+		}
+	}
+}
+
+func (s *actionSubscription) close() {
+	s.watcher.unsubscribe(s)
+}
@@ -0,0 +1,229 @@
+package hcloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// makeSharedWaiterTestUtils returns a client with a shared action watcher, and a server
+// that returns the requested actions with the status returned by the status function.
+func makeSharedWaiterTestUtils(t *testing.T, status func(id int64) string) (*Client, *atomic.Int32) {
+	requests := &atomic.Int32{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		resp := schema.ActionListResponse{Actions: []schema.Action{}}
+		for _, value := range r.URL.Query()["id"] {
+			id, err := strconv.ParseInt(value, 10, 64)
+			require.NoError(t, err)
+			if s := status(id); s != "" {
+				resp.Actions = append(resp.Actions, schema.Action{ID: id, Status: s})
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithPollOpts(PollOpts{BackoffFunc: ConstantBackoff(time.Millisecond), Coalesce: true}),
+	)
+	return client, requests
+}
+
+// awaitSubscriptions waits until the watcher of the client awaits n actions.
+func awaitSubscriptions(client *Client, n int) BackoffFunc {
+	return func(retries int) time.Duration {
+		for retries == 0 {
+			client.actionWatcher.mu.Lock()
+			count := len(client.actionWatcher.subs)
+			client.actionWatcher.mu.Unlock()
+			if count >= n {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		return time.Millisecond
+	}
+}
+
+func TestSharedActionWaiter(t *testing.T) {
+	t.Run("coalesced", func(t *testing.T) {
+		client, requests := makeSharedWaiterTestUtils(t, func(int64) string { return "success" })
+		client.pollBackoffFunc = awaitSubscriptions(client, 50)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 50)
+		for i := range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = client.Action.WaitFor(context.Background(), &Action{ID: int64(i + 1), Status: ActionStatusRunning})
+			}()
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("updates", func(t *testing.T) {
+		var polls atomic.Int32
+		client, _ := makeSharedWaiterTestUtils(t, func(id int64) string {
+			if id == 2 && polls.Add(1) < 3 {
+				return "running"
+			}
+			return "success"
+		})
+
+		updates := make([]int64, 0)
+		err := client.Action.WaitForFunc(context.Background(), func(update *Action) error {
+			updates = append(updates, update.ID)
+			return nil
+		},
+			&Action{ID: 1, Status: ActionStatusRunning},
+			&Action{ID: 2, Status: ActionStatusRunning},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 2, 2}, updates)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client, _ := makeSharedWaiterTestUtils(t, func(id int64) string {
+			if id == 2 {
+				return ""
+			}
+			return "running"
+		})
+
+		err := client.Action.WaitFor(context.Background(),
+			&Action{ID: 1, Status: ActionStatusRunning},
+			&Action{ID: 2, Status: ActionStatusRunning},
+		)
+		require.EqualError(t, err, "actions not found: [2]")
+
+		// The watcher stops polling once no action is awaited.
+		client.actionWatcher.mu.Lock()
+		defer client.actionWatcher.mu.Unlock()
+		assert.Empty(t, client.actionWatcher.subs)
+		assert.Nil(t, client.actionWatcher.cancel)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		client, _ := makeSharedWaiterTestUtils(t, func(int64) string { return "running" })
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := client.Action.WaitFor(ctx, &Action{ID: 1, Status: ActionStatusRunning})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorContains(t, err, "remaining running actions: [1]")
+
+		client.actionWatcher.mu.Lock()
+		defer client.actionWatcher.mu.Unlock()
+		assert.Empty(t, client.actionWatcher.subs)
+		assert.Nil(t, client.actionWatcher.cancel)
+	})
+
+	t.Run("shared action", func(t *testing.T) {
+		var polls atomic.Int32
+		client, _ := makeSharedWaiterTestUtils(t, func(int64) string {
+			if polls.Add(1) < 2 {
+				return "running"
+			}
+			return "success"
+		})
+		client.pollBackoffFunc = awaitSubscriptions(client, 1)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = client.Action.WaitFor(context.Background(), &Action{ID: 1, Status: ActionStatusRunning})
+			}()
+		}
+		wg.Wait()
+
+		assert.False(t, slices.ContainsFunc(errs, func(err error) bool { return err != nil }))
+	})
+	t.Run("backoff reset", func(t *testing.T) {
+		client, requests := makeSharedWaiterTestUtils(t, func(id int64) string {
+			if id == 1 {
+				return "running"
+			}
+			return "success"
+		})
+		client.pollBackoffFunc = func(retries int) time.Duration {
+			if retries == 0 {
+				return time.Millisecond
+			}
+			return time.Hour
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			_ = client.Action.WaitFor(ctx, &Action{ID: 1, Status: ActionStatusRunning})
+		}()
+		require.Eventually(t, func() bool { return requests.Load() > 0 }, time.Second, time.Millisecond)
+
+		// The watcher now waits for an hour, the new action must not.
+		waitCtx, waitCancel := context.WithTimeout(context.Background(), time.Second)
+		defer waitCancel()
+		require.NoError(t, client.Action.WaitFor(waitCtx, &Action{ID: 2, Status: ActionStatusRunning}))
+	})
+
+	t.Run("copied updates", func(t *testing.T) {
+		client, _ := makeSharedWaiterTestUtils(t, func(int64) string { return "success" })
+		client.pollBackoffFunc = func(retries int) time.Duration {
+			for retries == 0 {
+				client.actionWatcher.mu.Lock()
+				count := len(client.actionWatcher.subs[1])
+				client.actionWatcher.mu.Unlock()
+				if count >= 2 {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+			return time.Millisecond
+		}
+
+		var wg sync.WaitGroup
+		updates := make([]*Action, 2)
+		for i := range updates {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := client.Action.WaitForFunc(context.Background(), func(update *Action) error {
+					updates[i] = update
+					return nil
+				}, &Action{ID: 1, Status: ActionStatusRunning})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, updates[0], updates[1])
+		assert.NotSame(t, updates[0], updates[1])
+	})
+}
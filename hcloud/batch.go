@@ -0,0 +1,172 @@
+package hcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// BatchOperation is an operation run on each item of a batch, see [RunBatch]. The
+// returned actions, if any, are awaited once all the operations ran.
+type BatchOperation[T any] func(ctx context.Context, item T) ([]*Action, *Response, error)
+
+// BatchActionOperation returns a [BatchOperation] from a function returning a single
+// action, e.g. [ServerClient.Poweroff].
+func BatchActionOperation[T any](fn func(ctx context.Context, item T) (*Action, *Response, error)) BatchOperation[T] {
+	return func(ctx context.Context, item T) ([]*Action, *Response, error) {
+		action, resp, err := fn(ctx, item)
+		if err != nil || action == nil {
+			return nil, resp, err
+		}
+		return []*Action{action}, resp, nil
+	}
+}
+
+// BatchOpts defines the options used by [RunBatch].
+type BatchOpts struct {
+	// Concurrency is the maximum number of operations running in parallel. The number of
+	// parallel operations is further reduced when the remaining rate limit is low.
+	// Defaults to 1.
+	Concurrency int
+	// SkipWait disables waiting for the actions returned by the operations.
+	SkipWait bool
+}
+
+// BatchResult is the result of the operation on a single item of a batch.
+type BatchResult[T any] struct {
+	Item T
+	// Actions are the actions returned by the operation, in their latest known state.
+	Actions []*Action
+	// Err is the error returned by the operation, or the [ActionError] of the first
+	// failed action.
+	Err error
+}
+
+// BatchReport is the result of [RunBatch].
+type BatchReport[T any] struct {
+	// Results holds the result of each item, in the order of the items.
+	Results []BatchResult[T]
+}
+
+// Succeeded returns the results of the items whose operation succeeded.
+func (r *BatchReport[T]) Succeeded() []BatchResult[T] {
+	return slices.DeleteFunc(slices.Clone(r.Results), func(result BatchResult[T]) bool { return result.Err != nil })
+}
+
+// Failed returns the results of the items whose operation failed.
+func (r *BatchReport[T]) Failed() []BatchResult[T] {
+	return slices.DeleteFunc(slices.Clone(r.Results), func(result BatchResult[T]) bool { return result.Err == nil })
+}
+
+// Err returns the errors of all failed items joined together, or nil if all the
+// operations succeeded.
+func (r *BatchReport[T]) Err() error {
+	errs := make([]error, 0)
+	for _, result := range r.Failed() {
+		errs = append(errs, result.Err)
+	}
+	return errors.Join(errs...)
+}
+
+// RunBatch runs the operation on each item, and collects the per item results in a
+// [BatchReport]. A failed operation does not prevent the other operations from running.
+//
+// Once all the operations ran, the returned actions are awaited using
+// [ActionClient.WaitForFunc], unless [BatchOpts.SkipWait] is set. When the context is
+// canceled, the remaining items fail with the context error.
+//
+// For example, to delete a list of servers:
+//
+//	report := hcloud.RunBatch(ctx, client, servers,
+//		func(ctx context.Context, server *hcloud.Server) ([]*hcloud.Action, *hcloud.Response, error) {
+//			result, resp, err := client.Server.DeleteWithResult(ctx, server)
+//			if err != nil {
+//				return nil, resp, err
+//			}
+//			return []*hcloud.Action{result.Action}, resp, nil
+//		},
+//		hcloud.BatchOpts{Concurrency: 5},
+//	)
+//	if err := report.Err(); err != nil {
+//		// ...
+//	}
+func RunBatch[T any](ctx context.Context, client *Client, items []T, op BatchOperation[T], opts BatchOpts) *BatchReport[T] {
+	report := &BatchReport[T]{Results: make([]BatchResult[T], len(items))}
+	for i, item := range items {
+		report.Results[i].Item = item
+	}
+
+	runConcurrently(opts.Concurrency, len(items), Ratelimit{}, func(i int) (*Response, bool) {
+		result := &report.Results[i]
+		if err := ctx.Err(); err != nil {
+			result.Err = err
+			return nil, true
+		}
+
+		actions, resp, err := op(ctx, result.Item)
+		result.Actions = slices.DeleteFunc(actions, func(a *Action) bool { return a == nil })
+		result.Err = err
+		return resp, true
+	})
+
+	if !opts.SkipWait {
+		report.waitForActions(ctx, client)
+	}
+
+	return report
+}
+
+// waitForActions waits for the actions of the succeeded items, and updates the results
+// with the final state of the actions.
+func (r *BatchReport[T]) waitForActions(ctx context.Context, client *Client) {
+	type actionRef struct{ result, action int }
+
+	refs := make(map[int64][]actionRef)
+	actions := make([]*Action, 0)
+	for i, result := range r.Results {
+		if result.Err != nil {
+			continue
+		}
+		for j, action := range result.Actions {
+			if _, ok := refs[action.ID]; !ok {
+				actions = append(actions, action)
+			}
+			refs[action.ID] = append(refs[action.ID], actionRef{i, j})
+		}
+	}
+	if len(actions) == 0 {
+		return
+	}
+
+	done := make(map[int64]bool, len(actions))
+	err := client.Action.WaitForFunc(ctx, func(update *Action) error {
+		for _, ref := range refs[update.ID] {
+			result := &r.Results[ref.result]
+			result.Actions[ref.action] = update
+			if result.Err == nil && update.Status == ActionStatusError {
+				result.Err = update.Error()
+			}
+		}
+		if update.Status != ActionStatusRunning {
+			done[update.ID] = true
+		}
+		return nil
+	}, actions...)
+	if err == nil {
+		return
+	}
+
+	// Waiting stopped early, the items with unfinished actions are considered failed.
+	for id, actionRefs := range refs {
+		if done[id] {
+			continue
+		}
+		for _, ref := range actionRefs {
+			result := &r.Results[ref.result]
+			if result.Err == nil {
+				result.Err = fmt.Errorf("failed to wait for action %d: %w", id, err)
+			}
+		}
+	}
+}
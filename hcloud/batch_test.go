@@ -0,0 +1,147 @@
+package hcloud
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestRunBatch(t *testing.T) {
+	t.Run("delete servers", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "DELETE", Path: "/servers/1",
+				Status: 200,
+				JSON:   schema.ServerDeleteResponse{Action: schema.Action{ID: 11, Status: "running"}},
+			},
+			{
+				Method: "DELETE", Path: "/servers/2",
+				Status: 404,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeNotFound), Message: "server not found"}},
+			},
+			{
+				Method: "DELETE", Path: "/servers/3",
+				Status: 200,
+				JSON:   schema.ServerDeleteResponse{Action: schema.Action{ID: 13, Status: "running"}},
+			},
+			{
+				Method: "GET", Path: "/actions?id=11&id=13&page=1&sort=status&sort=id",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{ID: 11, Status: "success", Progress: 100},
+						{ID: 13, Status: "error", Error: &schema.ActionError{Code: "action_failed", Message: "Action failed"}},
+					},
+				},
+			},
+		})
+
+		servers := []*Server{{ID: 1}, {ID: 2}, {ID: 3}}
+		report := RunBatch(ctx, client, servers,
+			func(ctx context.Context, server *Server) ([]*Action, *Response, error) {
+				result, resp, err := client.Server.DeleteWithResult(ctx, server)
+				if err != nil {
+					return nil, resp, err
+				}
+				return []*Action{result.Action}, resp, nil
+			},
+			BatchOpts{},
+		)
+
+		require.Len(t, report.Results, 3)
+
+		assert.Equal(t, servers[0], report.Results[0].Item)
+		assert.NoError(t, report.Results[0].Err)
+		require.Len(t, report.Results[0].Actions, 1)
+		assert.Equal(t, ActionStatusSuccess, report.Results[0].Actions[0].Status)
+
+		assert.True(t, IsError(report.Results[1].Err, ErrorCodeNotFound))
+		assert.Empty(t, report.Results[1].Actions)
+
+		actionErr := ActionError{}
+		require.ErrorAs(t, report.Results[2].Err, &actionErr)
+		assert.Equal(t, "action_failed", actionErr.Code)
+		assert.Equal(t, int64(13), actionErr.Action().ID)
+
+		assert.Len(t, report.Succeeded(), 1)
+		assert.Len(t, report.Failed(), 2)
+		assert.EqualError(t, report.Err(), "server not found (not_found)\nAction failed (action_failed, 13)")
+	})
+
+	t.Run("action operation", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "POST", Path: "/servers/1/actions/poweroff",
+				Status: 201,
+				JSON:   schema.ServerActionPoweroffResponse{Action: schema.Action{ID: 11, Status: "success"}},
+			},
+		})
+
+		report := RunBatch(ctx, client, []*Server{{ID: 1}}, BatchActionOperation(client.Server.Poweroff), BatchOpts{})
+		require.NoError(t, report.Err())
+		assert.Equal(t, int64(11), report.Results[0].Actions[0].ID)
+	})
+
+	t.Run("concurrency", func(t *testing.T) {
+		client := NewClient()
+
+		var mu sync.Mutex
+		inflight, maxInflight := 0, 0
+
+		report := RunBatch(context.Background(), client, []int{1, 2, 3, 4, 5, 6},
+			func(_ context.Context, item int) ([]*Action, *Response, error) {
+				mu.Lock()
+				inflight++
+				maxInflight = max(maxInflight, inflight)
+				mu.Unlock()
+
+				time.Sleep(time.Millisecond)
+
+				mu.Lock()
+				inflight--
+				mu.Unlock()
+
+				if item == 4 {
+					return nil, nil, errors.New("failure")
+				}
+				return nil, &Response{Meta: Meta{Ratelimit: Ratelimit{Limit: 3600, Remaining: 2}}}, nil
+			},
+			BatchOpts{Concurrency: 4, SkipWait: true},
+		)
+
+		assert.LessOrEqual(t, maxInflight, 4)
+		assert.EqualError(t, report.Err(), "failure")
+		assert.Len(t, report.Succeeded(), 5)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := NewClient()
+
+		report := RunBatch(ctx, client, []int{1, 2, 3},
+			func(_ context.Context, item int) ([]*Action, *Response, error) {
+				if item == 2 {
+					cancel()
+				}
+				return nil, nil, nil
+			},
+			BatchOpts{},
+		)
+
+		assert.NoError(t, report.Results[0].Err)
+		assert.NoError(t, report.Results[1].Err)
+		assert.ErrorIs(t, report.Results[2].Err, context.Canceled)
+	})
+}
@@ -0,0 +1,202 @@
+package hcloud
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// CatalogCacheOpts defines the options used by [WithCatalogCache].
+type CatalogCacheOpts struct {
+	// TTL is the duration after which the cached resources are fetched again. Defaults
+	// to 1 hour.
+	TTL time.Duration
+}
+
+// WithCatalogCache configures a Client to cache the near-static catalog resources in
+// memory: server types, load balancer types, locations, datacenters, ISOs, storage box
+// types and pricing.
+//
+// The first lookup of a resource kind fetches all resources of that kind, the following
+// lookups (e.g. [ServerTypeClient.GetByName]) are served from memory until the TTL
+// expires. Lookups served from the cache return a nil [Response]. The returned
+// resources are shared between the callers, and must not be modified.
+//
+// The cache can be invalidated using [Client.InvalidateCatalogCache].
+func WithCatalogCache(opts CatalogCacheOpts) ClientOption {
+	return func(client *Client) {
+		if opts.TTL <= 0 {
+			opts.TTL = time.Hour
+		}
+		client.catalogCacheOpts = &opts
+	}
+}
+
+// InvalidateCatalogCache removes all resources from the catalog cache, see
+// [WithCatalogCache]. The next lookups fetch the resources again.
+func (c *Client) InvalidateCatalogCache() {
+	if c.catalogCache == nil {
+		return
+	}
+	c.catalogCache.invalidate()
+}
+
+type catalogCache struct {
+	serverTypes       *catalogList[ServerType]
+	loadBalancerTypes *catalogList[LoadBalancerType]
+	locations         *catalogList[Location]
+	datacenters       *catalogList[Datacenter]
+	isos              *catalogList[ISO]
+	storageBoxTypes   *catalogList[StorageBoxType]
+	pricing           *catalogEntry[Pricing]
+}
+
+func newCatalogCache(client *Client, opts CatalogCacheOpts) *catalogCache {
+	now := time.Now
+	return &catalogCache{
+		serverTypes: newCatalogList(opts.TTL, now, func(ctx context.Context) ([]*ServerType, error) {
+			return client.ServerType.AllWithOpts(ctx, ServerTypeListOpts{})
+		}),
+		loadBalancerTypes: newCatalogList(opts.TTL, now, func(ctx context.Context) ([]*LoadBalancerType, error) {
+			return client.LoadBalancerType.AllWithOpts(ctx, LoadBalancerTypeListOpts{})
+		}),
+		locations: newCatalogList(opts.TTL, now, func(ctx context.Context) ([]*Location, error) {
+			return client.Location.AllWithOpts(ctx, LocationListOpts{})
+		}),
+		datacenters: newCatalogList(opts.TTL, now, func(ctx context.Context) ([]*Datacenter, error) {
+			return client.Datacenter.AllWithOpts(ctx, DatacenterListOpts{})
+		}),
+		isos: newCatalogList(opts.TTL, now, func(ctx context.Context) ([]*ISO, error) {
+			return client.ISO.AllWithOpts(ctx, ISOListOpts{})
+		}),
+		storageBoxTypes: newCatalogList(opts.TTL, now, func(ctx context.Context) ([]*StorageBoxType, error) {
+			return client.StorageBoxType.AllWithOpts(ctx, StorageBoxTypeListOpts{})
+		}),
+		pricing: &catalogEntry[Pricing]{ttl: opts.TTL, now: now, fetch: func(ctx context.Context) (Pricing, error) {
+			pricing, _, err := client.Pricing.fetch(ctx)
+			return pricing, err
+		}},
+	}
+}
+
+func (c *catalogCache) invalidate() {
+	c.serverTypes.invalidate()
+	c.loadBalancerTypes.invalidate()
+	c.locations.invalidate()
+	c.datacenters.invalidate()
+	c.isos.invalidate()
+	c.storageBoxTypes.invalidate()
+	c.pricing.invalidate()
+}
+
+// catalogEntry holds a cached value, fetched again once the TTL expired.
+//
+// Concurrent lookups share a single fetch, which is not canceled when the context of
+// the lookup that started it is done.
+type catalogEntry[V any] struct {
+	ttl   time.Duration
+	now   func() time.Time
+	fetch func(ctx context.Context) (V, error)
+
+	mu        sync.Mutex
+	value     V
+	expiresAt time.Time
+	inflight  *catalogFetch[V]
+}
+
+// catalogFetch is a fetch of a [catalogEntry], awaited by the concurrent lookups.
+type catalogFetch[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+func (e *catalogEntry[V]) get(ctx context.Context) (V, error) {
+	e.mu.Lock()
+	if e.now().Before(e.expiresAt) {
+		value := e.value
+		e.mu.Unlock()
+		return value, nil
+	}
+	call := e.inflight
+	if call == nil {
+		call = &catalogFetch[V]{done: make(chan struct{})}
+		e.inflight = call
+		go e.run(context.WithoutCancel(ctx), call)
+	}
+	e.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	case <-call.done:
+	}
+
+	if call.err != nil {
+		var zero V
+		return zero, call.err
+	}
+	return call.value, nil
+}
+
+// run fetches the value and stores it, unless the entry was invalidated meanwhile.
+func (e *catalogEntry[V]) run(ctx context.Context, call *catalogFetch[V]) {
+	defer close(call.done)
+
+	call.value, call.err = e.fetch(ctx)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.inflight != call {
+		return
+	}
+	e.inflight = nil
+	if call.err == nil {
+		e.value = call.value
+		e.expiresAt = e.now().Add(e.ttl)
+	}
+}
+
+func (e *catalogEntry[V]) invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var zero V
+	e.value = zero
+	e.expiresAt = time.Time{}
+	e.inflight = nil
+}
+
+// catalogList is a [catalogEntry] holding a list of resources.
+type catalogList[T any] struct {
+	catalogEntry[[]*T]
+}
+
+func newCatalogList[T any](ttl time.Duration, now func() time.Time, fetch func(ctx context.Context) ([]*T, error)) *catalogList[T] {
+	return &catalogList[T]{catalogEntry[[]*T]{ttl: ttl, now: now, fetch: fetch}}
+}
+
+// all returns all the cached resources.
+func (l *catalogList[T]) all(ctx context.Context) ([]*T, error) {
+	items, err := l.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Clone(items), nil
+}
+
+// find returns the first cached resource matching the function, or nil if none matches.
+// The [Response] is always nil, as the lookup does not send any request.
+func (l *catalogList[T]) find(ctx context.Context, match func(*T) bool) (*T, *Response, error) {
+	items, err := l.get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if i := slices.IndexFunc(items, match); i >= 0 {
+		return items[i], nil, nil
+	}
+	return nil, nil, nil
+}
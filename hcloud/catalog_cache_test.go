@@ -0,0 +1,140 @@
+package hcloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+)
+
+func TestCatalogCache(t *testing.T) {
+	ctx := context.Background()
+
+	serverTypesRequest := mockutil.Request{
+		Method: "GET", Path: "/server_types?page=1&per_page=50",
+		Status: 200,
+		JSONRaw: `{
+			"server_types": [
+				{ "id": 1, "name": "cx22" },
+				{ "id": 2, "name": "cx32" }
+			],
+			"meta": { "pagination": { "page": 1 }}
+		}`,
+	}
+
+	server := mockutil.NewServer(t, []mockutil.Request{
+		serverTypesRequest,
+		{
+			Method: "GET", Path: "/pricing",
+			Status:  200,
+			JSONRaw: `{ "pricing": { "currency": "EUR" }}`,
+		},
+		serverTypesRequest,
+		serverTypesRequest,
+	})
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithRetryOpts(RetryOpts{BackoffFunc: ConstantBackoff(0), MaxRetries: 5}),
+		WithCatalogCache(CatalogCacheOpts{TTL: time.Minute}),
+	)
+
+	now := time.Now()
+	client.catalogCache.serverTypes.now = func() time.Time { return now }
+
+	{
+		serverType, resp, err := client.ServerType.GetByName(ctx, "cx32")
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, int64(2), serverType.ID)
+	}
+	{
+		// Served from the cache
+		serverType, _, err := client.ServerType.GetByID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "cx22", serverType.Name)
+
+		serverType, _, err = client.ServerType.Get(ctx, "cx52")
+		require.NoError(t, err)
+		assert.Nil(t, serverType)
+
+		serverTypes, err := client.ServerType.All(ctx)
+		require.NoError(t, err)
+		assert.Len(t, serverTypes, 2)
+	}
+	{
+		pricing, _, err := client.Pricing.Get(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", pricing.Currency)
+
+		// Served from the cache
+		pricing, _, err = client.Pricing.Get(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", pricing.Currency)
+	}
+	{
+		// Fetched again after the TTL expired
+		now = now.Add(time.Minute)
+
+		serverType, _, err := client.ServerType.GetByName(ctx, "cx22")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), serverType.ID)
+	}
+	{
+		// Fetched again after the invalidation
+		client.InvalidateCatalogCache()
+
+		serverType, _, err := client.ServerType.GetByName(ctx, "cx22")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), serverType.ID)
+	}
+}
+
+func TestCatalogEntry(t *testing.T) {
+	t.Run("canceled lookup", func(t *testing.T) {
+		fetches := make(chan struct{})
+		release := make(chan struct{})
+
+		entry := &catalogEntry[string]{ttl: time.Minute, now: time.Now, fetch: func(ctx context.Context) (string, error) {
+			fetches <- struct{}{}
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-release:
+				return "value", nil
+			}
+		}}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := entry.get(ctx)
+			errCh <- err
+		}()
+		<-fetches
+
+		resultCh := make(chan string, 1)
+		go func() {
+			value, err := entry.get(context.Background())
+			assert.NoError(t, err)
+			resultCh <- value
+		}()
+
+		// The lookup that started the fetch is canceled, the fetch continues for the
+		// other lookups.
+		cancel()
+		require.ErrorIs(t, <-errCh, context.Canceled)
+
+		close(release)
+		assert.Equal(t, "value", <-resultCh)
+
+		// Served from the cache
+		value, err := entry.get(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "value", value)
+	})
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -173,7 +174,20 @@ func (c *CertificateClient) AllWithOpts(ctx context.Context, opts CertificateLis
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Certificate, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Certificate, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all Certificates for the given options.
+// The pages are fetched lazily while iterating.
+func (c *CertificateClient) Iter(ctx context.Context, opts CertificateListOpts) iter.Seq2[*Certificate, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Certificate, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -337,3 +351,33 @@ func (c *CertificateClient) RetryIssuance(ctx context.Context, certificate *Cert
 
 	return ActionFromSchema(respBody.Action), resp, nil
 }
+
+// WaitUntil fetches the certificate at the interval defined by [WithPollOpts], until the
+// condition holds (e.g. [CertificateIsIssued]), and returns the latest state of the
+// certificate.
+//
+// The function stops waiting when the condition returns an error, when the certificate
+// does not exist, or when the context is done.
+func (c *CertificateClient) WaitUntil(ctx context.Context, certificate *Certificate, condition func(*Certificate) (bool, error)) (*Certificate, error) {
+	return waitUntil(ctx, c.client, fmt.Sprintf("certificate %d", certificate.ID),
+		func(ctx context.Context) (*Certificate, *Response, error) { return c.GetByID(ctx, certificate.ID) },
+		condition,
+	)
+}
+
+// CertificateIsIssued is a [CertificateClient.WaitUntil] condition which holds when the
+// issuance of a managed certificate completed. Uploaded certificates are always issued.
+//
+// The condition returns the error of the certificate status if the issuance failed.
+func CertificateIsIssued(certificate *Certificate) (bool, error) {
+	if certificate.Type == CertificateTypeUploaded || certificate.Status == nil {
+		return true, nil
+	}
+	if certificate.Status.IsFailed() {
+		if certificate.Status.Error != nil {
+			return false, *certificate.Status.Error
+		}
+		return false, fmt.Errorf("certificate %d issuance failed", certificate.ID)
+	}
+	return certificate.Status.Issuance == CertificateStatusTypeCompleted, nil
+}
@@ -15,6 +15,8 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"golang.org/x/net/http/httpguts"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/instrumentation"
@@ -90,16 +92,34 @@ type Client struct {
 	hetznerEndpoint         string
 	token                   string
 	tokenValid              bool
+	tokenSource             TokenSource
 	retryBackoffFunc        BackoffFunc
 	retryMaxRetries         int
+	retryPolicy             RetryPolicy
+	retryOnRetry            func(req *http.Request, attempt int, err error, backoff time.Duration)
+	rateLimiter             *rateLimiter
+	circuitBreaker          *circuitBreaker
 	pollBackoffFunc         BackoffFunc
+	pollCoalesce            bool
+	actionWatcher           *actionWatcher
+	pageConcurrency         int
+	idempotentCreate        bool
+	catalogCacheOpts        *CatalogCacheOpts
+	catalogCache            *catalogCache
 	httpClient              *http.Client
 	applicationName         string
 	applicationVersion      string
 	userAgent               string
 	debugWriter             io.Writer
+	loggerOpts              LoggerOpts
+	redactedFields          []string
 	instrumentationRegistry prometheus.Registerer
-	handler                 handler
+	instrumenter            *instrumentation.Instrumenter
+	project                 string
+	tracer                  trace.Tracer
+	tracingEnabled          bool
+	middlewares             map[MiddlewarePosition][]Middleware
+	handler                 Handler
 
 	Action           ActionClient
 	Certificate      CertificateClient
@@ -192,6 +212,12 @@ func WithPollBackoffFunc(f BackoffFunc) ClientOption {
 // PollOpts defines the options used by [WithPollOpts].
 type PollOpts struct {
 	BackoffFunc BackoffFunc
+	// Coalesce enables a watcher shared by all the [ActionClient.WaitForFunc] calls of
+	// the Client. The actions awaited by concurrent calls are then polled together,
+	// with a single list request per 25 actions, instead of separate requests for each
+	// call. The watcher polls at the interval defined by the BackoffFunc, starting over
+	// once no action is awaited.
+	Coalesce bool
 }
 
 // WithPollOpts configures a Client to use the specified options when polling from the API.
@@ -202,6 +228,7 @@ func WithPollOpts(opts PollOpts) ClientOption {
 		if opts.BackoffFunc != nil {
 			client.pollBackoffFunc = opts.BackoffFunc
 		}
+		client.pollCoalesce = opts.Coalesce
 	}
 }
 
@@ -221,17 +248,30 @@ func WithBackoffFunc(f BackoffFunc) ClientOption {
 type RetryOpts struct {
 	BackoffFunc BackoffFunc
 	MaxRetries  int
+	// Policy decides whether a failed request should be retried. Defaults to
+	// [DefaultRetryPolicy].
+	Policy RetryPolicy
+	// OnRetry is called before waiting for the next retry, with the retry attempt
+	// (starting at 1), the error of the previous attempt and the chosen backoff.
+	OnRetry func(req *http.Request, attempt int, err error, backoff time.Duration)
 }
 
 // WithRetryOpts configures a Client to use the specified options when retrying API
 // requests.
 //
-// If [RetryOpts.BackoffFunc] is nil, the existing backoff function will be preserved.
+// If [RetryOpts.BackoffFunc], [RetryOpts.Policy] or [RetryOpts.OnRetry] are nil, the
+// existing values will be preserved.
 func WithRetryOpts(opts RetryOpts) ClientOption {
 	return func(client *Client) {
 		if opts.BackoffFunc != nil {
 			client.retryBackoffFunc = opts.BackoffFunc
 		}
+		if opts.Policy != nil {
+			client.retryPolicy = opts.Policy
+		}
+		if opts.OnRetry != nil {
+			client.retryOnRetry = opts.OnRetry
+		}
 		client.retryMaxRetries = opts.MaxRetries
 	}
 }
@@ -261,6 +301,19 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+// WithPageConcurrency configures a Client to fetch up to concurrency pages in parallel,
+// when retrieving all the items of a list (e.g. [ServerClient.AllWithOpts]).
+//
+// The first page is fetched on its own, to learn the number of pages. The remaining
+// pages are then fetched concurrently, while the number of concurrent requests is
+// reduced when the remaining rate limit is low. The items are returned in the page
+// order. Defaults to 1, fetching the pages one after the other.
+func WithPageConcurrency(concurrency int) ClientOption {
+	return func(client *Client) {
+		client.pageConcurrency = concurrency
+	}
+}
+
 // WithInstrumentation configures a Client to collect metrics about the performed HTTP requests.
 func WithInstrumentation(registry prometheus.Registerer) ClientOption {
 	return func(client *Client) {
@@ -283,8 +336,11 @@ func NewClient(options ...ClientOption) *Client {
 			Jitter:     true,
 		}),
 		retryMaxRetries: 5,
+		retryPolicy:     DefaultRetryPolicy,
 
 		pollBackoffFunc: ConstantBackoff(500 * time.Millisecond),
+
+		tracer: noop.NewTracerProvider().Tracer(tracerName),
 	}
 
 	for _, option := range options {
@@ -293,12 +349,22 @@ func NewClient(options ...ClientOption) *Client {
 
 	client.buildUserAgent()
 	if client.instrumentationRegistry != nil {
-		i := instrumentation.New("api", client.instrumentationRegistry)
-		client.httpClient.Transport = i.InstrumentedRoundTripper(client.httpClient.Transport)
+		client.instrumenter = instrumentation.New("api", client.instrumentationRegistry)
+		if client.project != "" {
+			client.instrumenter = client.instrumenter.WithProject(client.project)
+		}
+		client.httpClient.Transport = client.instrumenter.InstrumentedRoundTripper(client.httpClient.Transport)
 	}
 
 	client.handler = assembleHandlerChain(client)
 
+	if client.catalogCacheOpts != nil {
+		client.catalogCache = newCatalogCache(client, *client.catalogCacheOpts)
+	}
+	if client.pollCoalesce {
+		client.actionWatcher = newActionWatcher(client)
+	}
+
 	// Cloud API
 	client.Action = ActionClient{action: &ResourceActionClient[noopResource]{client: client}}
 	client.Datacenter = DatacenterClient{client: client}
@@ -3,41 +3,156 @@ package hcloud
 import (
 	"context"
 	"net/http"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/instrumentation"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/redact"
 )
 
-// handler is an interface representing a client request transaction. The handler are
+// Handler is an interface representing a client request transaction. The handler are
 // meant to be chained, similarly to the [http.RoundTripper] interface.
 //
 // The handler chain is placed between the [Client] API operations and the
-// [http.Client].
-type handler interface {
+// [http.Client]. See [WithMiddleware] to insert your own handlers in the chain.
+type Handler interface {
 	Do(req *http.Request, v any) (resp *Response, err error)
 }
 
+// HandlerFunc is an adapter to allow the use of ordinary functions as [Handler].
+type HandlerFunc func(req *http.Request, v any) (resp *Response, err error)
+
+// Do calls f(req, v).
+func (f HandlerFunc) Do(req *http.Request, v any) (*Response, error) {
+	return f(req, v)
+}
+
+// Middleware wraps a [Handler] to extend its behavior. The returned [Handler] is
+// responsible for calling the next [Handler].
+type Middleware func(next Handler) Handler
+
+// MiddlewarePosition defines where a [Middleware] is inserted in the handler chain.
+type MiddlewarePosition int
+
+const (
+	// MiddlewarePositionClient inserts the middleware at the top of the handler chain.
+	// The middleware is called once per [Client.Do] call, and sees the final result
+	// after all retries. The [Response.Meta] is fully populated, and v is decoded once
+	// the next handler returns.
+	MiddlewarePositionClient MiddlewarePosition = iota
+
+	// MiddlewarePositionAttempt inserts the middleware below the retry handler. The
+	// middleware is called for every attempt, and sees the API errors as [Error]. The
+	// [Response.Meta.Ratelimit] is populated, but not the [Response.Meta.Pagination].
+	// The v argument is always nil.
+	MiddlewarePositionAttempt
+
+	// MiddlewarePositionTransport inserts the middleware right above the [http.Client].
+	// The middleware is called for every attempt, and sees the raw [Response] before
+	// any API error is built from it. The v argument is always nil.
+	MiddlewarePositionTransport
+)
+
+// WithMiddleware configures a Client to insert the given middlewares in the handler
+// chain at the given position.
+//
+// Middlewares are applied in the given order, the first middleware is the outermost
+// one. Calling WithMiddleware multiple times with the same position appends the
+// middlewares below the previously configured ones.
+func WithMiddleware(position MiddlewarePosition, middlewares ...Middleware) ClientOption {
+	return func(client *Client) {
+		if client.middlewares == nil {
+			client.middlewares = make(map[MiddlewarePosition][]Middleware)
+		}
+		client.middlewares[position] = append(client.middlewares[position], middlewares...)
+	}
+}
+
+// wrapMiddlewares wraps the handler with the middlewares, the first middleware being
+// the outermost one.
+func wrapMiddlewares(h Handler, middlewares []Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
 // assembleHandlerChain assembles the chain of handlers used to make API requests.
 //
 // The order of the handlers is important.
-func assembleHandlerChain(client *Client) handler {
+func assembleHandlerChain(client *Client) Handler {
+	redactor := redact.New(redact.DefaultFields, client.redactedFields)
+
 	// Start down the chain: sending the http request
 	h := newHTTPHandler(client.httpClient)
 
+	// Insert user middlewares operating on the raw responses
+	h = wrapMiddlewares(h, client.middlewares[MiddlewarePositionTransport])
+
 	// Insert debug writer if enabled
 	if client.debugWriter != nil {
-		h = wrapDebugHandler(h, client.debugWriter)
+		h = wrapDebugHandler(h, client.debugWriter, redactor)
 	}
 
 	// Read rate limit headers
 	h = wrapRateLimitHandler(h)
 
+	// Delay requests when the rate limit budget is exhausted if enabled
+	if client.rateLimiter != nil {
+		h = wrapRateLimiterHandler(h, client.rateLimiter)
+	}
+
 	// Build error from response
 	h = wrapErrorHandler(h)
 
+	// Authenticate the request using the token source if enabled
+	if client.tokenSource != nil {
+		h = wrapTokenSourceHandler(h, client.tokenSource)
+	}
+
+	// Fail fast when the API endpoint is unhealthy if enabled
+	if client.circuitBreaker != nil {
+		var circuitBreakerMetrics *instrumentation.CircuitBreakerMetrics
+		if client.instrumenter != nil {
+			circuitBreakerMetrics = client.instrumenter.CircuitBreakerMetrics()
+		}
+		h = wrapCircuitBreakerHandler(h, client.circuitBreaker, circuitBreakerMetrics)
+	}
+
+	// Log each attempt if enabled
+	if client.loggerOpts.Logger != nil {
+		h = wrapLoggerHandler(h, client.loggerOpts, redactor)
+	}
+
+	// Insert user middlewares operating on each attempt
+	h = wrapMiddlewares(h, client.middlewares[MiddlewarePositionAttempt])
+
+	// Record each attempt in the request span if enabled
+	if client.tracingEnabled {
+		h = wrapTracingAttemptHandler(h)
+	}
+
 	// Retry request if condition are met
-	h = wrapRetryHandler(h, client.retryBackoffFunc, client.retryMaxRetries)
+	var retryMetrics *instrumentation.RetryMetrics
+	if client.instrumenter != nil {
+		retryMetrics = client.instrumenter.RetryMetrics()
+	}
+	h = wrapRetryHandler(h, RetryOpts{
+		BackoffFunc: client.retryBackoffFunc,
+		MaxRetries:  client.retryMaxRetries,
+		Policy:      client.retryPolicy,
+		OnRetry:     client.retryOnRetry,
+	}, retryMetrics)
+
+	// Create a span covering all attempts if enabled
+	if client.tracingEnabled {
+		h = wrapTracingHandler(h, client.tracer)
+	}
 
 	// Finally parse the response body into the provided schema
 	h = wrapParseHandler(h)
 
+	// Insert user middlewares operating on the final result
+	h = wrapMiddlewares(h, client.middlewares[MiddlewarePositionClient])
+
 	return h
 }
 
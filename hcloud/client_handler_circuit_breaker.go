@@ -0,0 +1,241 @@
+package hcloud
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/instrumentation"
+)
+
+// CircuitBreakerOpts defines the options used by [WithCircuitBreaker].
+type CircuitBreakerOpts struct {
+	// FailureThreshold is the number of consecutive failures after which the circuit
+	// opens. Defaults to 5.
+	FailureThreshold int
+	// Cooldown is the duration the circuit stays open, before a single trial request is
+	// allowed (half-open). Defaults to 30 seconds.
+	Cooldown time.Duration
+}
+
+// WithCircuitBreaker configures a Client to stop sending requests to an API endpoint
+// after consecutive failures, and to fail fast with a [CircuitBreakerOpenError]
+// instead. The endpoints are identified by their operation path (e.g. /servers/-).
+//
+// Failures are [ErrorCodeBadGateway] or [ErrorCodeTimeout] API errors, HTTP 502 or 504
+// responses, and network errors. After the cooldown, a single trial request is sent,
+// its success closes the circuit while its failure opens the circuit again.
+//
+// The state of the circuits is exported as metrics when [WithInstrumentation] is
+// configured.
+func WithCircuitBreaker(opts CircuitBreakerOpts) ClientOption {
+	return func(client *Client) {
+		if opts.FailureThreshold <= 0 {
+			opts.FailureThreshold = 5
+		}
+		if opts.Cooldown <= 0 {
+			opts.Cooldown = 30 * time.Second
+		}
+		client.circuitBreaker = &circuitBreaker{opts: opts, circuits: make(map[string]*circuit), now: time.Now}
+	}
+}
+
+// CircuitBreakerOpenError is returned when a request is not sent because the circuit of
+// its API endpoint is open.
+type CircuitBreakerOpenError struct {
+	Endpoint string
+	Until    time.Time
+}
+
+func (e CircuitBreakerOpenError) Error() string {
+	return fmt.Sprintf("hcloud: circuit breaker is open for endpoint %s until %s", e.Endpoint, e.Until.Format(time.RFC3339))
+}
+
+// CircuitState represents the state of a circuit.
+type CircuitState int
+
+// List of circuit states.
+const (
+	CircuitStateClosed CircuitState = iota
+	CircuitStateHalfOpen
+	CircuitStateOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitStateClosed:
+		return "closed"
+	case CircuitStateHalfOpen:
+		return "half-open"
+	case CircuitStateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+func wrapCircuitBreakerHandler(wrapped Handler, breaker *circuitBreaker, metrics *instrumentation.CircuitBreakerMetrics) Handler {
+	return &circuitBreakerHandler{wrapped, breaker, metrics}
+}
+
+type circuitBreakerHandler struct {
+	handler Handler
+	breaker *circuitBreaker
+	metrics *instrumentation.CircuitBreakerMetrics
+}
+
+func (h *circuitBreakerHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	endpoint := ctxutil.OpPath(req.Context())
+	if endpoint == "" {
+		endpoint = req.URL.Path
+	}
+
+	c := h.breaker.circuit(endpoint)
+
+	if err := c.allow(); err != nil {
+		if h.metrics != nil {
+			h.metrics.ObserveRejected(req)
+			h.metrics.ObserveState(req, int(c.state()))
+		}
+		return nil, err
+	}
+
+	resp, err = h.handler.Do(req, v)
+
+	switch {
+	case req.Context().Err() != nil:
+		// The outcome of canceled requests does not tell anything about the API health.
+		c.release()
+	case circuitBreakerFailure(resp, err):
+		c.failure()
+	default:
+		c.success()
+	}
+
+	if h.metrics != nil {
+		h.metrics.ObserveState(req, int(c.state()))
+	}
+
+	return resp, err
+}
+
+// circuitBreakerFailure returns whether the error indicates an unhealthy API.
+func circuitBreakerFailure(resp *Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	switch {
+	case IsError(err, ErrorCodeBadGateway, ErrorCodeTimeout):
+		return true
+	case errors.Is(err, ErrStatusCode) && resp != nil && resp.Response != nil:
+		return resp.StatusCode == http.StatusBadGateway || resp.StatusCode == http.StatusGatewayTimeout
+	case errors.As(err, &netErr):
+		return true
+	}
+	return false
+}
+
+// circuitBreaker holds a circuit per API endpoint.
+type circuitBreaker struct {
+	opts CircuitBreakerOpts
+	now  func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+func (b *circuitBreaker) circuit(endpoint string) *circuit {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[endpoint]
+	if !ok {
+		c = &circuit{breaker: b, endpoint: endpoint}
+		b.circuits[endpoint] = c
+	}
+	return c
+}
+
+type circuit struct {
+	breaker  *circuitBreaker
+	endpoint string
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// state returns the current state of the circuit.
+func (c *circuit) state() CircuitState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stateLocked()
+}
+
+func (c *circuit) stateLocked() CircuitState {
+	switch {
+	case c.failures < c.breaker.opts.FailureThreshold:
+		return CircuitStateClosed
+	case c.trial || !c.breaker.now().Before(c.openedAt.Add(c.breaker.opts.Cooldown)):
+		return CircuitStateHalfOpen
+	default:
+		return CircuitStateOpen
+	}
+}
+
+// allow returns an error if the request must not be sent. In the half-open state, a
+// single trial request is allowed.
+func (c *circuit) allow() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.stateLocked() {
+	case CircuitStateClosed:
+		return nil
+	case CircuitStateHalfOpen:
+		if !c.trial {
+			c.trial = true
+			return nil
+		}
+	case CircuitStateOpen:
+	}
+
+	return CircuitBreakerOpenError{
+		Endpoint: c.endpoint,
+		Until:    c.openedAt.Add(c.breaker.opts.Cooldown),
+	}
+}
+
+func (c *circuit) success() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failures = 0
+	c.trial = false
+}
+
+func (c *circuit) failure() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failures++
+	if c.failures >= c.breaker.opts.FailureThreshold {
+		c.openedAt = c.breaker.now()
+	}
+	c.trial = false
+}
+
+func (c *circuit) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.trial = false
+}
@@ -0,0 +1,104 @@
+package hcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/instrumentation"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestCircuitBreakerHandler(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	healthy := true
+	calls := 0
+	m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+		calls++
+		if healthy {
+			return fakeResponse(t, 200, "", false), nil
+		}
+		return nil, ErrorFromSchema(schema.Error{Code: string(ErrorCodeBadGateway), Message: "Bad Gateway"})
+	}}
+
+	client := NewClient(WithCircuitBreaker(CircuitBreakerOpts{FailureThreshold: 2, Cooldown: time.Minute}))
+	client.circuitBreaker.now = func() time.Time { return now }
+
+	registry := prometheus.NewRegistry()
+	h := wrapCircuitBreakerHandler(m, client.circuitBreaker, instrumentation.New("api", registry).CircuitBreakerMetrics())
+
+	do := func(path string) error {
+		ctx := ctxutil.SetOpPath(context.Background(), path)
+		req, err := client.NewRequest(ctx, "GET", "/servers", nil)
+		require.NoError(t, err)
+		_, err = h.Do(req, nil)
+		return err
+	}
+
+	healthy = false
+	require.EqualError(t, do("/servers"), "Bad Gateway (bad_gateway)")
+	require.EqualError(t, do("/servers"), "Bad Gateway (bad_gateway)")
+	assert.Equal(t, 2, calls)
+
+	// Circuit is open
+	err := do("/servers")
+	require.EqualError(t, err, "hcloud: circuit breaker is open for endpoint /servers until 2023-11-14T22:14:20Z")
+	assert.ErrorAs(t, err, &CircuitBreakerOpenError{})
+	assert.Equal(t, 2, calls)
+
+	// Other endpoints are not affected
+	healthy = true
+	require.NoError(t, do("/volumes"))
+	assert.Equal(t, 3, calls)
+
+	// Trial request fails
+	healthy = false
+	now = now.Add(time.Minute)
+	assert.Equal(t, CircuitStateHalfOpen, client.circuitBreaker.circuit("/servers").state())
+	require.EqualError(t, do("/servers"), "Bad Gateway (bad_gateway)")
+	require.ErrorAs(t, do("/servers"), &CircuitBreakerOpenError{})
+	assert.Equal(t, 4, calls)
+
+	// Trial request succeeds
+	healthy = true
+	now = now.Add(time.Minute)
+	require.NoError(t, do("/servers"))
+	require.NoError(t, do("/servers"))
+	assert.Equal(t, 6, calls)
+	assert.Equal(t, CircuitStateClosed, client.circuitBreaker.circuit("/servers").state())
+
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP hcloud_api_circuit_breaker_rejected_total A counter for requests to the hcloud api rejected by the circuit breaker per endpoint.
+# TYPE hcloud_api_circuit_breaker_rejected_total counter
+hcloud_api_circuit_breaker_rejected_total{api_endpoint="/servers"} 2
+# HELP hcloud_api_circuit_breaker_state A gauge of the circuit breaker state (0=closed, 1=half-open, 2=open) for the hcloud api per endpoint.
+# TYPE hcloud_api_circuit_breaker_state gauge
+hcloud_api_circuit_breaker_state{api_endpoint="/servers"} 0
+hcloud_api_circuit_breaker_state{api_endpoint="/volumes"} 0
+`)))
+}
+
+func TestCircuitBreakerFailure(t *testing.T) {
+	assert.True(t, circuitBreakerFailure(nil, ErrorFromSchema(schema.Error{Code: string(ErrorCodeTimeout)})))
+	assert.True(t, circuitBreakerFailure(fakeResponse(t, 504, "", false), fmt.Errorf("%w %d", ErrStatusCode, 504)))
+	assert.True(t, circuitBreakerFailure(&Response{}, &timeoutError{}))
+	assert.False(t, circuitBreakerFailure(fakeResponse(t, 503, "", false), fmt.Errorf("%w %d", ErrStatusCode, 503)))
+	assert.False(t, circuitBreakerFailure(nil, ErrorFromSchema(schema.Error{Code: string(ErrorCodeConflict)})))
+	assert.False(t, circuitBreakerFailure(fakeResponse(t, 200, "", false), nil))
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
@@ -1,20 +1,24 @@
 package hcloud
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httputil"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/redact"
 )
 
-func wrapDebugHandler(wrapped handler, output io.Writer) handler {
-	return &debugHandler{wrapped, output}
+func wrapDebugHandler(wrapped Handler, output io.Writer, redactor *redact.Redactor) Handler {
+	return &debugHandler{wrapped, output, redactor}
 }
 
 type debugHandler struct {
-	handler handler
-	output  io.Writer
+	handler  Handler
+	output   io.Writer
+	redactor *redact.Redactor
 }
 
 func (h *debugHandler) Do(req *http.Request, v any) (resp *Response, err error) {
@@ -27,6 +31,16 @@ func (h *debugHandler) Do(req *http.Request, v any) (resp *Response, err error)
 
 	cloned.Header.Set("Authorization", "REDACTED")
 
+	if cloned.Body != nil {
+		body, err := io.ReadAll(cloned.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = h.redactor.Body(body)
+		cloned.Body = io.NopCloser(bytes.NewReader(body))
+		cloned.ContentLength = int64(len(body))
+	}
+
 	dumpReq, err := httputil.DumpRequestOut(cloned, true)
 	if err != nil {
 		return nil, err
@@ -39,12 +53,12 @@ func (h *debugHandler) Do(req *http.Request, v any) (resp *Response, err error)
 		return resp, err
 	}
 
-	dumpResp, err := httputil.DumpResponse(resp.Response, true)
+	dumpResp, err := httputil.DumpResponse(resp.Response, false)
 	if err != nil {
 		return nil, err
 	}
 
-	fmt.Fprintf(h.output, "--- Response:\n%s\n\n", dumpResp)
+	fmt.Fprintf(h.output, "--- Response:\n%s%s\n\n", dumpResp, h.redactor.Body(resp.body))
 
 	return resp, err
 }
@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/redact"
 )
 
 func TestDebugHandler(t *testing.T) {
@@ -88,7 +90,7 @@ Content-Type: application/json
 			buf := bytes.NewBuffer(nil)
 
 			m := &mockHandler{testCase.wrapped}
-			h := wrapDebugHandler(m, buf)
+			h := wrapDebugHandler(m, buf, redact.New(redact.DefaultFields))
 
 			client := NewClient(WithToken("dummy"))
 			client.userAgent = "hcloud-go/testing"
@@ -104,3 +106,44 @@ Content-Type: application/json
 		})
 	}
 }
+
+func TestDebugHandlerRedaction(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+
+	m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+		return fakeResponse(t, 201, `{"server": {"id": 1234}, "root_password": "secret"}`, true), nil
+	}}
+	h := wrapDebugHandler(m, buf, redact.New(redact.DefaultFields, []string{"user_data"}))
+
+	client := NewClient(WithToken("dummy"))
+	client.userAgent = "hcloud-go/testing"
+
+	req, err := client.NewRequest(context.Background(), "POST", "/servers", bytes.NewBufferString(`{"name":"testing","user_data":"#cloud-config"}`))
+	require.NoError(t, err)
+
+	_, err = h.Do(req, nil)
+	require.NoError(t, err)
+
+	re := regexp.MustCompile(`\r`)
+	output := re.ReplaceAllString(buf.String(), "")
+	assert.Equal(t, `--- Request:
+POST /v1/servers HTTP/1.1
+Host: api.hetzner.cloud
+User-Agent: hcloud-go/testing
+Content-Length: 41
+Accept: application/json
+Authorization: REDACTED
+Content-Type: application/json
+Accept-Encoding: gzip
+
+{"name":"testing","user_data":"REDACTED"}
+
+--- Response:
+HTTP/1.1 201 Created
+Connection: close
+Content-Type: application/json
+
+{"root_password":"REDACTED","server":{"id":1234}}
+
+`, output)
+}
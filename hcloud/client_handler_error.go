@@ -11,12 +11,12 @@ import (
 
 var ErrStatusCode = errors.New("server responded with status code")
 
-func wrapErrorHandler(wrapped handler) handler {
+func wrapErrorHandler(wrapped Handler) Handler {
 	return &errorHandler{wrapped}
 }
 
 type errorHandler struct {
-	handler handler
+	handler Handler
 }
 
 func (h *errorHandler) Do(req *http.Request, v any) (resp *Response, err error) {
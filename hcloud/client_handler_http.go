@@ -4,7 +4,7 @@ import (
 	"net/http"
 )
 
-func newHTTPHandler(httpClient *http.Client) handler {
+func newHTTPHandler(httpClient *http.Client) Handler {
 	return &httpHandler{httpClient}
 }
 
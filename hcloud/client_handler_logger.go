@@ -0,0 +1,132 @@
+package hcloud
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/redact"
+)
+
+// LoggerOpts defines the options used by [WithLoggerOpts].
+type LoggerOpts struct {
+	// Logger receives a record for every request sent to the API.
+	Logger *slog.Logger
+	// Level is the level of the records. Defaults to [slog.LevelDebug].
+	Level slog.Leveler
+	// Body enables logging the request and response bodies. Sensitive fields (e.g.
+	// passwords) are redacted, see [WithRedactedFields].
+	Body bool
+}
+
+// WithLogger configures a Client to log a structured record for every request sent to
+// the API. See [WithLoggerOpts] for more options.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return WithLoggerOpts(LoggerOpts{Logger: logger})
+}
+
+// WithLoggerOpts configures a Client to log a structured record for every request sent
+// to the API, using the specified options.
+//
+// Each record contains the request method, operation path, response status, duration,
+// retry attempt, correlation ID and rate limit information.
+func WithLoggerOpts(opts LoggerOpts) ClientOption {
+	return func(client *Client) {
+		if opts.Level == nil {
+			opts.Level = slog.LevelDebug
+		}
+		client.loggerOpts = opts
+	}
+}
+
+// attemptKey is the key for the retry attempt in Contexts.
+type attemptKey struct{}
+
+// withAttempt returns a copy of ctx that holds the retry attempt of the request.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// attemptFromContext returns the retry attempt of the request, 0 being the first
+// attempt.
+func attemptFromContext(ctx context.Context) int {
+	attempt, _ := ctx.Value(attemptKey{}).(int)
+	return attempt
+}
+
+func wrapLoggerHandler(wrapped Handler, opts LoggerOpts, redactor *redact.Redactor) Handler {
+	return &loggerHandler{wrapped, opts, redactor}
+}
+
+type loggerHandler struct {
+	handler  Handler
+	opts     LoggerOpts
+	redactor *redact.Redactor
+}
+
+func (h *loggerHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	ctx := req.Context()
+	if !h.opts.Logger.Enabled(ctx, h.opts.Level.Level()) {
+		return h.handler.Do(req, v)
+	}
+
+	var reqBody []byte
+	if h.opts.Body && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			reqBody, _ = io.ReadAll(body)
+			_ = body.Close()
+		}
+	}
+
+	start := time.Now()
+	resp, err = h.handler.Do(req, v)
+	duration := time.Since(start)
+
+	opPath := ctxutil.OpPath(ctx)
+	if opPath == "" {
+		opPath = req.URL.Path
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("op_path", opPath),
+		slog.Duration("duration", duration),
+		slog.Int("attempt", attemptFromContext(ctx)),
+	}
+
+	if resp != nil && resp.Response != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+
+		if correlationID := resp.internalCorrelationID(); correlationID != "" {
+			attrs = append(attrs, slog.String("correlation_id", correlationID))
+		}
+
+		if ratelimit := resp.Meta.Ratelimit; ratelimit.Limit > 0 {
+			attrs = append(attrs, slog.Group("ratelimit",
+				slog.Int("limit", ratelimit.Limit),
+				slog.Int("remaining", ratelimit.Remaining),
+				slog.Time("reset", ratelimit.Reset),
+			))
+		}
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+
+	if h.opts.Body {
+		if len(reqBody) > 0 {
+			attrs = append(attrs, slog.String("request_body", string(h.redactor.Body(reqBody))))
+		}
+		if resp != nil && resp.Response != nil && resp.hasJSONBody() {
+			attrs = append(attrs, slog.String("response_body", string(h.redactor.Body(resp.body))))
+		}
+	}
+
+	h.opts.Logger.LogAttrs(ctx, h.opts.Level.Level(), "api request", attrs...)
+
+	return resp, err
+}
@@ -0,0 +1,157 @@
+package hcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/redact"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestLoggerHandler(t *testing.T) {
+	testCases := []struct {
+		name    string
+		body    bool
+		wrapped func(req *http.Request, v any) (*Response, error)
+		want    map[string]any
+	}{
+		{
+			name: "network error",
+			wrapped: func(_ *http.Request, _ any) (*Response, error) {
+				return &Response{}, fmt.Errorf("network error")
+			},
+			want: map[string]any{
+				"level":   "DEBUG",
+				"msg":     "api request",
+				"method":  "POST",
+				"op_path": "/servers",
+				"attempt": float64(2),
+				"error":   "network error",
+			},
+		},
+		{
+			name: "http 200",
+			body: true,
+			wrapped: func(_ *http.Request, _ any) (*Response, error) {
+				resp := fakeResponse(t, 201, `{"server": {"id": 1}, "root_password": "secret"}`, true)
+				resp.Header.Set(headerCorrelationID, "c1b2a3")
+				resp.Meta.Ratelimit = Ratelimit{Limit: 3600, Remaining: 3599}
+				return resp, nil
+			},
+			want: map[string]any{
+				"level":          "DEBUG",
+				"msg":            "api request",
+				"method":         "POST",
+				"op_path":        "/servers",
+				"attempt":        float64(2),
+				"status":         float64(201),
+				"correlation_id": "c1b2a3",
+				"ratelimit": map[string]any{
+					"limit":     float64(3600),
+					"remaining": float64(3599),
+					"reset":     "0001-01-01T00:00:00Z",
+				},
+				"request_body":  `{"name":"my-cert","private_key":"REDACTED"}`,
+				"response_body": `{"root_password":"REDACTED","server":{"id":1}}`,
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+			m := &mockHandler{testCase.wrapped}
+			h := wrapLoggerHandler(m, LoggerOpts{Logger: logger, Level: slog.LevelDebug, Body: testCase.body}, redact.New(redact.DefaultFields))
+
+			client := NewClient(WithToken("dummy"))
+
+			ctx := ctxutil.SetOpPath(context.Background(), "/servers")
+			ctx = withAttempt(ctx, 2)
+			req, err := client.NewRequest(ctx, "POST", "/servers",
+				strings.NewReader(`{"name":"my-cert","private_key":"secret"}`))
+			require.NoError(t, err)
+
+			h.Do(req, nil)
+
+			var record map[string]any
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+			assert.NotEmpty(t, record["time"])
+			assert.Contains(t, record, "duration")
+			delete(record, "time")
+			delete(record, "duration")
+
+			assert.Equal(t, testCase.want, record)
+		})
+	}
+}
+
+func TestLoggerHandlerDisabled(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+		return fakeResponse(t, 200, "", false), nil
+	}}
+	h := wrapLoggerHandler(m, LoggerOpts{Logger: logger, Level: slog.LevelDebug}, redact.New(redact.DefaultFields))
+
+	req, err := http.NewRequest("GET", "/", nil)
+	require.NoError(t, err)
+
+	_, err = h.Do(req, nil)
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestClientWithLogger(t *testing.T) {
+	ctx, server, _ := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  409,
+			JSONRaw: `{"error": {"code": "conflict", "message": "Conflict"}}`,
+		},
+		{
+			Method: "GET", Path: "/servers/1",
+			Status: 200,
+			JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 1}},
+		},
+	})
+
+	buf := bytes.NewBuffer(nil)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithRetryOpts(RetryOpts{BackoffFunc: ConstantBackoff(0), MaxRetries: 5}),
+		WithLogger(logger),
+	)
+
+	server1, _, err := client.Server.GetByID(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, server1)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	for i, line := range lines {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+
+		assert.Equal(t, "/servers/-", record["op_path"])
+		assert.Equal(t, float64(i), record["attempt"])
+	}
+}
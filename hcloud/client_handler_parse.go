@@ -10,12 +10,12 @@ import (
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
 )
 
-func wrapParseHandler(wrapped handler) handler {
+func wrapParseHandler(wrapped Handler) Handler {
 	return &parseHandler{wrapped}
 }
 
 type parseHandler struct {
-	handler handler
+	handler Handler
 }
 
 func (h *parseHandler) Do(req *http.Request, v any) (resp *Response, err error) {
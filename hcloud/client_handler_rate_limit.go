@@ -6,12 +6,12 @@ import (
 	"time"
 )
 
-func wrapRateLimitHandler(wrapped handler) handler {
+func wrapRateLimitHandler(wrapped Handler) Handler {
 	return &rateLimitHandler{wrapped}
 }
 
 type rateLimitHandler struct {
-	handler handler
+	handler Handler
 }
 
 func (h *rateLimitHandler) Do(req *http.Request, v any) (resp *Response, err error) {
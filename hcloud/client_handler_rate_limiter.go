@@ -0,0 +1,190 @@
+package hcloud
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiterOpts defines the options used by [WithRateLimiter].
+type RateLimiterOpts struct {
+	// Reserved is the part of the rate limit budget that is reserved for high priority
+	// requests. Low priority requests are delayed as long as the remaining budget is
+	// lower or equal to this value. When Reserved is greater than or equal to the limit
+	// reported by the API, low priority requests wait until the budget is full.
+	Reserved int
+	// LowPriority returns whether the request is a low priority request. If nil, all GET
+	// requests are considered low priority.
+	LowPriority func(req *http.Request) bool
+}
+
+// WithRateLimiter configures a Client to proactively delay requests when the rate
+// limit budget reported by the API (see [Ratelimit]) approaches zero, instead of
+// receiving [ErrorCodeRateLimitExceeded] errors.
+//
+// The budget is shared by all concurrent requests of the Client, and is estimated
+// using the RateLimit-* headers of the responses and the number of requests in flight.
+func WithRateLimiter(opts RateLimiterOpts) ClientOption {
+	return func(client *Client) {
+		if opts.LowPriority == nil {
+			opts.LowPriority = func(req *http.Request) bool { return req.Method == http.MethodGet }
+		}
+		client.rateLimiter = &rateLimiter{opts: opts, budgets: make(map[string]*rateLimitBudget)}
+	}
+}
+
+func wrapRateLimiterHandler(wrapped Handler, limiter *rateLimiter) Handler {
+	return &rateLimiterHandler{wrapped, limiter}
+}
+
+type rateLimiterHandler struct {
+	handler Handler
+	limiter *rateLimiter
+}
+
+func (h *rateLimiterHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	budget := h.limiter.budget(req.URL.Host)
+
+	if err := budget.wait(req.Context(), h.limiter.required(req)); err != nil {
+		return nil, err
+	}
+
+	resp, err = h.handler.Do(req, v)
+
+	var ratelimit Ratelimit
+	if resp != nil {
+		ratelimit = resp.Meta.Ratelimit
+	}
+	budget.done(ratelimit, time.Now())
+
+	return resp, err
+}
+
+// rateLimiter holds a rate limit budget per API host.
+type rateLimiter struct {
+	opts RateLimiterOpts
+
+	mu      sync.Mutex
+	budgets map[string]*rateLimitBudget
+}
+
+func (l *rateLimiter) budget(host string) *rateLimitBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.budgets[host]
+	if !ok {
+		b = &rateLimitBudget{}
+		l.budgets[host] = b
+	}
+	return b
+}
+
+// required returns the budget that must be available before sending the request.
+func (l *rateLimiter) required(req *http.Request) int {
+	if l.opts.LowPriority(req) {
+		return l.opts.Reserved + 1
+	}
+	return 1
+}
+
+// rateLimitBudget estimates the remaining rate limit budget. The API refills the budget
+// continuously until it is full at the reset time, we therefore derive the refill rate
+// from the last reported values.
+type rateLimitBudget struct {
+	mu sync.Mutex
+
+	limit     int
+	remaining int
+	reset     time.Time
+	updated   time.Time
+	inFlight  int
+}
+
+// wait blocks until the required budget is available, and reserves a request from the
+// budget.
+func (b *rateLimitBudget) wait(ctx context.Context, required int) error {
+	for {
+		delay := b.reserve(time.Now(), required)
+		if delay <= 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// reserve reserves a request from the budget if the required budget is available,
+// otherwise it returns the duration to wait before trying again. The required budget is
+// capped at the limit, which is the most that can ever be available.
+func (b *rateLimitBudget) reserve(now time.Time, required int) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// The budget is unknown until we receive the first response.
+	if b.limit == 0 {
+		b.inFlight++
+		return 0
+	}
+
+	required = min(required, b.limit)
+
+	available := b.available(now)
+	if available >= float64(required) {
+		b.inFlight++
+		return 0
+	}
+
+	rate := b.refillRate()
+	if rate <= 0 {
+		// Requests in flight will report a new budget.
+		return time.Second
+	}
+
+	delay := time.Duration((float64(required) - available) / rate * float64(time.Second))
+	if reset := b.reset.Sub(now); reset > 0 && delay > reset {
+		delay = reset
+	}
+	return max(delay, time.Millisecond)
+}
+
+// done releases a request in flight and updates the budget with the rate limit
+// information of the response, if any.
+func (b *rateLimitBudget) done(ratelimit Ratelimit, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.inFlight = max(b.inFlight-1, 0)
+
+	if ratelimit.Limit > 0 {
+		b.limit = ratelimit.Limit
+		b.remaining = ratelimit.Remaining
+		b.reset = ratelimit.Reset
+		b.updated = now
+	}
+}
+
+// available returns the estimated budget available at the given time.
+func (b *rateLimitBudget) available(now time.Time) float64 {
+	if !now.Before(b.reset) {
+		return float64(b.limit - b.inFlight)
+	}
+
+	refilled := float64(b.remaining) + b.refillRate()*now.Sub(b.updated).Seconds()
+	return math.Min(float64(b.limit), refilled) - float64(b.inFlight)
+}
+
+// refillRate returns the estimated number of requests refilled per second.
+func (b *rateLimitBudget) refillRate() float64 {
+	window := b.reset.Sub(b.updated).Seconds()
+	if window <= 0 {
+		return 0
+	}
+	return float64(b.limit-b.remaining) / window
+}
@@ -0,0 +1,107 @@
+package hcloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitBudget(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	t.Run("unknown budget", func(t *testing.T) {
+		b := &rateLimitBudget{}
+		assert.Equal(t, time.Duration(0), b.reserve(now, 1))
+		assert.Equal(t, 1, b.inFlight)
+	})
+
+	t.Run("available budget", func(t *testing.T) {
+		b := &rateLimitBudget{}
+		b.done(Ratelimit{Limit: 3600, Remaining: 10, Reset: now.Add(3590 * time.Second)}, now)
+
+		assert.Equal(t, time.Duration(0), b.reserve(now, 1))
+		assert.Equal(t, time.Duration(0), b.reserve(now, 9))
+		assert.Equal(t, 2, b.inFlight)
+	})
+
+	t.Run("exhausted budget", func(t *testing.T) {
+		b := &rateLimitBudget{}
+		b.done(Ratelimit{Limit: 3600, Remaining: 0, Reset: now.Add(3600 * time.Second)}, now)
+
+		// Refills 1 request per second
+		assert.Equal(t, time.Second, b.reserve(now, 1))
+		assert.Equal(t, 5*time.Second, b.reserve(now, 5))
+		assert.Equal(t, 0, b.inFlight)
+
+		assert.Equal(t, time.Duration(0), b.reserve(now.Add(time.Second), 1))
+		assert.Equal(t, 1, b.inFlight)
+
+		// Budget is full after the reset
+		assert.Equal(t, time.Duration(0), b.reserve(now.Add(time.Hour), 100))
+	})
+
+	t.Run("required above limit", func(t *testing.T) {
+		b := &rateLimitBudget{}
+		b.done(Ratelimit{Limit: 10, Remaining: 10, Reset: now}, now)
+
+		// The requirement is capped at the limit, once the budget is full.
+		assert.Equal(t, time.Duration(0), b.reserve(now, 11))
+		assert.Equal(t, time.Second, b.reserve(now, 11))
+
+		b.done(Ratelimit{Limit: 10, Remaining: 9, Reset: now.Add(time.Second)}, now)
+		assert.Equal(t, time.Second, b.reserve(now, 10))
+		assert.Equal(t, time.Duration(0), b.reserve(now.Add(time.Second), 10))
+	})
+
+	t.Run("requests in flight", func(t *testing.T) {
+		b := &rateLimitBudget{}
+		b.done(Ratelimit{Limit: 3600, Remaining: 2, Reset: now.Add(3598 * time.Second)}, now)
+
+		assert.Equal(t, time.Duration(0), b.reserve(now, 1))
+		assert.Equal(t, time.Duration(0), b.reserve(now, 1))
+		assert.Equal(t, time.Second, b.reserve(now, 1))
+
+		b.done(Ratelimit{Limit: 3600, Remaining: 1, Reset: now.Add(3599 * time.Second)}, now)
+		assert.Equal(t, time.Second, b.reserve(now, 1))
+
+		b.done(Ratelimit{}, now)
+		assert.Equal(t, time.Duration(0), b.reserve(now, 1))
+	})
+}
+
+func TestRateLimiterHandler(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Unix()
+
+	m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+		resp := fakeResponse(t, 200, "", false)
+		resp.Meta.Ratelimit = Ratelimit{Limit: 3600, Remaining: 5, Reset: time.Unix(reset, 0)}
+		return resp, nil
+	}}
+
+	client := NewClient(WithRateLimiter(RateLimiterOpts{Reserved: 10}))
+	h := wrapRateLimiterHandler(m, client.rateLimiter)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Mutating request are not affected by the reserved budget
+	req, err := client.NewRequest(ctx, "POST", "/servers", nil)
+	require.NoError(t, err)
+	_, err = h.Do(req, nil)
+	require.NoError(t, err)
+
+	req, err = client.NewRequest(ctx, "POST", "/servers", nil)
+	require.NoError(t, err)
+	_, err = h.Do(req, nil)
+	require.NoError(t, err)
+
+	// Low priority requests must wait until the reserved budget is refilled
+	req, err = client.NewRequest(ctx, "GET", "/servers", nil)
+	require.NoError(t, err)
+	_, err = h.Do(req, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
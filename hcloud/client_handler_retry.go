@@ -4,17 +4,103 @@ import (
 	"errors"
 	"net"
 	"net/http"
+	"strconv"
 	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/instrumentation"
 )
 
-func wrapRetryHandler(wrapped handler, backoffFunc BackoffFunc, maxRetries int) handler {
-	return &retryHandler{wrapped, backoffFunc, maxRetries}
+// A RetryPolicy returns whether a failed request should be retried. The resp argument
+// may be nil or hold a nil [http.Response] (e.g. on network errors).
+//
+// When the returned delay is positive, it is used instead of the delay returned by the
+// [RetryOpts.BackoffFunc].
+type RetryPolicy func(req *http.Request, resp *Response, err error) (retry bool, delay time.Duration)
+
+// DefaultRetryPolicy is the [RetryPolicy] used by default. It retries requests on API
+// errors that are known to be temporary ([ErrorCodeConflict],
+// [ErrorCodeRateLimitExceeded], [ErrorCodeBadGateway] and [ErrorCodeTimeout]), on HTTP
+// 502 and 504 responses, and on network timeouts.
+func DefaultRetryPolicy(_ *http.Request, resp *Response, err error) (bool, time.Duration) {
+	return retryPolicy(resp, err), 0
+}
+
+// ErrorCodeRetryPolicy returns a [RetryPolicy] which retries requests that failed with
+// an API error matching one of the given error codes.
+//
+// For example, to retry requests on [ErrorCodeLocked] and [ErrorCodeResourceUnavailable]
+// in addition to the [DefaultRetryPolicy]:
+//
+//	hcloud.WithRetryOpts(hcloud.RetryOpts{
+//		MaxRetries: 5,
+//		Policy: hcloud.AnyRetryPolicy(
+//			hcloud.DefaultRetryPolicy,
+//			hcloud.ErrorCodeRetryPolicy(hcloud.ErrorCodeLocked, hcloud.ErrorCodeResourceUnavailable),
+//		),
+//	})
+func ErrorCodeRetryPolicy(codes ...ErrorCode) RetryPolicy {
+	return func(_ *http.Request, _ *Response, err error) (bool, time.Duration) {
+		return IsError(err, codes...), 0
+	}
+}
+
+// AnyRetryPolicy returns a [RetryPolicy] which retries requests when any of the given
+// policies does. The delay of the first matching policy is used.
+func AnyRetryPolicy(policies ...RetryPolicy) RetryPolicy {
+	return func(req *http.Request, resp *Response, err error) (bool, time.Duration) {
+		for _, policy := range policies {
+			if retry, delay := policy(req, resp, err); retry {
+				return true, delay
+			}
+		}
+		return false, 0
+	}
+}
+
+// IdempotentRetryPolicy returns a [RetryPolicy] which never retries non-idempotent POST
+// requests, except when they failed with [ErrorCodeConflict]. All other requests are
+// retried according to the given policy.
+func IdempotentRetryPolicy(policy RetryPolicy) RetryPolicy {
+	return func(req *http.Request, resp *Response, err error) (bool, time.Duration) {
+		if req.Method == http.MethodPost && !IsError(err, ErrorCodeConflict) {
+			return false, 0
+		}
+		return policy(req, resp, err)
+	}
+}
+
+// RetryAfterPolicy returns a [RetryPolicy] which honors the Retry-After header of the
+// response, when the given policy decides to retry the request.
+func RetryAfterPolicy(policy RetryPolicy) RetryPolicy {
+	return func(req *http.Request, resp *Response, err error) (bool, time.Duration) {
+		retry, delay := policy(req, resp, err)
+		if !retry || resp == nil || resp.Response == nil {
+			return retry, delay
+		}
+
+		if value := resp.Header.Get("Retry-After"); value != "" {
+			if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+				return true, time.Duration(seconds) * time.Second
+			}
+			if date, err := http.ParseTime(value); err == nil {
+				if d := time.Until(date); d > 0 {
+					return true, d
+				}
+			}
+		}
+
+		return retry, delay
+	}
+}
+
+func wrapRetryHandler(wrapped Handler, opts RetryOpts, metrics *instrumentation.RetryMetrics) Handler {
+	return &retryHandler{wrapped, opts, metrics}
 }
 
 type retryHandler struct {
-	handler     handler
-	backoffFunc BackoffFunc
-	maxRetries  int
+	handler Handler
+	opts    RetryOpts
+	metrics *instrumentation.RetryMetrics
 }
 
 func (h *retryHandler) Do(req *http.Request, v any) (resp *Response, err error) {
@@ -23,7 +109,7 @@ func (h *retryHandler) Do(req *http.Request, v any) (resp *Response, err error)
 
 	for {
 		// Clone the request using the original context
-		cloned, err := cloneRequest(req, ctx)
+		cloned, err := cloneRequest(req, withAttempt(ctx, retries))
 		if err != nil {
 			return nil, err
 		}
@@ -39,14 +125,49 @@ func (h *retryHandler) Do(req *http.Request, v any) (resp *Response, err error)
 				return resp, err
 			}
 
-			if retries < h.maxRetries && retryPolicy(resp, err) {
-				select {
-				case <-ctx.Done():
-					return resp, err
-				case <-time.After(h.backoffFunc(retries)):
-					retries++
-					continue
+			retry, delay := h.opts.Policy(req, resp, err)
+			if retry && retries >= h.opts.MaxRetries {
+				if h.metrics != nil && h.opts.MaxRetries > 0 {
+					h.metrics.ObserveRetriesExhausted(req)
+				}
+				retry = false
+			}
+
+			// The request may have created the resource, look it up before retrying to
+			// prevent creating a duplicate. A uniqueness error after a retry is not
+			// retried, but may be caused by the resource created by a previous attempt.
+			if lookup := createLookupFromContext(ctx); lookup != nil && ambiguousCreateError(resp, err, retries) &&
+				(retry || IsError(err, ErrorCodeUniquenessError)) {
+				found, lookupErr := lookup()
+				if lookupErr != nil {
+					return resp, createLookupError{err: err, lookupErr: lookupErr}
 				}
+				if found {
+					return resp, errCreatedByPreviousAttempt
+				}
+			}
+
+			if !retry {
+				return resp, err
+			}
+
+			if delay <= 0 {
+				delay = h.opts.BackoffFunc(retries)
+			}
+
+			if h.opts.OnRetry != nil {
+				h.opts.OnRetry(req, retries+1, err, delay)
+			}
+			if h.metrics != nil {
+				h.metrics.ObserveRetry(req, retryReason(resp, err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return resp, err
+			case <-time.After(delay):
+				retries++
+				continue
 			}
 		}
 
@@ -54,6 +175,23 @@ func (h *retryHandler) Do(req *http.Request, v any) (resp *Response, err error)
 	}
 }
 
+// retryReason returns a short description of the error that caused a retry.
+func retryReason(resp *Response, err error) string {
+	var apiErr Error
+	var netErr net.Error
+
+	switch {
+	case errors.As(err, &apiErr):
+		return string(apiErr.Code)
+	case errors.Is(err, ErrStatusCode) && resp != nil && resp.Response != nil:
+		return "http_" + strconv.Itoa(resp.StatusCode)
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "network_timeout"
+	default:
+		return "other"
+	}
+}
+
 func retryPolicy(resp *Response, err error) bool {
 	if err != nil {
 		var apiErr Error
@@ -3,13 +3,20 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/instrumentation"
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
 )
 
@@ -86,17 +93,21 @@ func TestRetryHandler(t *testing.T) {
 			m := &mockHandler{testCase.wrapped}
 
 			retryCount := 0
-			h := wrapRetryHandler(m, func(retries int) time.Duration {
-				assert.Equal(t, retryCount, retries)
+			h := wrapRetryHandler(m, RetryOpts{
+				BackoffFunc: func(retries int) time.Duration {
+					assert.Equal(t, retryCount, retries)
 
-				if testCase.recover {
-					// Reset the mock handler to exit the retry loop
-					m.f = func(_ *http.Request, _ any) (*Response, error) { return nil, nil }
-				}
+					if testCase.recover {
+						// Reset the mock handler to exit the retry loop
+						m.f = func(_ *http.Request, _ any) (*Response, error) { return nil, nil }
+					}
 
-				retryCount++
-				return 0
-			}, 5)
+					retryCount++
+					return 0
+				},
+				MaxRetries: 5,
+				Policy:     DefaultRetryPolicy,
+			}, nil)
 
 			client := NewClient(WithToken("dummy"))
 			req, err := client.NewRequest(context.Background(), "GET", "/", nil)
@@ -193,3 +204,210 @@ func TestRetryPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryPolicies(t *testing.T) {
+	conflictErr := ErrorFromSchema(schema.Error{Code: string(ErrorCodeConflict)})
+	lockedErr := ErrorFromSchema(schema.Error{Code: string(ErrorCodeLocked)})
+	unavailableErr := ErrorFromSchema(schema.Error{Code: string(ErrorCodeResourceUnavailable)})
+	rateLimitErr := ErrorFromSchema(schema.Error{Code: string(ErrorCodeRateLimitExceeded)})
+
+	withRetryAfter := func(value string) *Response {
+		resp := fakeResponse(t, 429, "", false)
+		resp.Header.Set("Retry-After", value)
+		return resp
+	}
+
+	testCases := []struct {
+		name      string
+		policy    RetryPolicy
+		method    string
+		resp      *Response
+		err       error
+		wantRetry bool
+		wantDelay time.Duration
+	}{
+		{
+			name:      "error codes: locked",
+			policy:    ErrorCodeRetryPolicy(ErrorCodeLocked, ErrorCodeResourceUnavailable),
+			method:    "POST",
+			err:       lockedErr,
+			wantRetry: true,
+		},
+		{
+			name:      "error codes: resource_unavailable",
+			policy:    ErrorCodeRetryPolicy(ErrorCodeLocked, ErrorCodeResourceUnavailable),
+			method:    "POST",
+			err:       unavailableErr,
+			wantRetry: true,
+		},
+		{
+			name:      "error codes: conflict",
+			policy:    ErrorCodeRetryPolicy(ErrorCodeLocked, ErrorCodeResourceUnavailable),
+			method:    "POST",
+			err:       conflictErr,
+			wantRetry: false,
+		},
+		{
+			name:      "any: default",
+			policy:    AnyRetryPolicy(DefaultRetryPolicy, ErrorCodeRetryPolicy(ErrorCodeLocked)),
+			method:    "GET",
+			err:       conflictErr,
+			wantRetry: true,
+		},
+		{
+			name:      "any: locked",
+			policy:    AnyRetryPolicy(DefaultRetryPolicy, ErrorCodeRetryPolicy(ErrorCodeLocked)),
+			method:    "GET",
+			err:       lockedErr,
+			wantRetry: true,
+		},
+		{
+			name:      "idempotent: post conflict",
+			policy:    IdempotentRetryPolicy(DefaultRetryPolicy),
+			method:    "POST",
+			err:       conflictErr,
+			wantRetry: true,
+		},
+		{
+			name:      "idempotent: post rate limit",
+			policy:    IdempotentRetryPolicy(DefaultRetryPolicy),
+			method:    "POST",
+			err:       rateLimitErr,
+			wantRetry: false,
+		},
+		{
+			name:      "idempotent: get rate limit",
+			policy:    IdempotentRetryPolicy(DefaultRetryPolicy),
+			method:    "GET",
+			err:       rateLimitErr,
+			wantRetry: true,
+		},
+		{
+			name:      "retry after: seconds",
+			policy:    RetryAfterPolicy(DefaultRetryPolicy),
+			method:    "GET",
+			resp:      withRetryAfter("3"),
+			err:       rateLimitErr,
+			wantRetry: true,
+			wantDelay: 3 * time.Second,
+		},
+		{
+			name:      "retry after: invalid",
+			policy:    RetryAfterPolicy(DefaultRetryPolicy),
+			method:    "GET",
+			resp:      withRetryAfter("invalid"),
+			err:       rateLimitErr,
+			wantRetry: true,
+		},
+		{
+			name:      "retry after: no retry",
+			policy:    RetryAfterPolicy(DefaultRetryPolicy),
+			method:    "GET",
+			resp:      withRetryAfter("3"),
+			err:       lockedErr,
+			wantRetry: false,
+		},
+		{
+			name:      "retry after: network error",
+			policy:    RetryAfterPolicy(ErrorCodeRetryPolicy(ErrorCodeLocked)),
+			method:    "GET",
+			resp:      &Response{},
+			err:       lockedErr,
+			wantRetry: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(testCase.method, "/", nil)
+			require.NoError(t, err)
+
+			retry, delay := testCase.policy(req, testCase.resp, testCase.err)
+			assert.Equal(t, testCase.wantRetry, retry)
+			assert.Equal(t, testCase.wantDelay, delay)
+		})
+	}
+}
+
+func TestRetryHandlerPolicyDelay(t *testing.T) {
+	attempts := 0
+	m := &mockHandler{func(_ *http.Request, _ any) (*Response, error) {
+		attempts++
+		if attempts > 1 {
+			return nil, nil
+		}
+		return nil, ErrorFromSchema(schema.Error{Code: string(ErrorCodeLocked)})
+	}}
+
+	h := wrapRetryHandler(m, RetryOpts{
+		BackoffFunc: func(_ int) time.Duration {
+			t.Fatal("backoff function must not be called")
+			return 0
+		},
+		MaxRetries: 5,
+		Policy: func(_ *http.Request, _ *Response, err error) (bool, time.Duration) {
+			return IsError(err, ErrorCodeLocked), time.Millisecond
+		},
+	}, nil)
+
+	client := NewClient(WithToken("dummy"))
+	req, err := client.NewRequest(context.Background(), "POST", "/", nil)
+	require.NoError(t, err)
+
+	_, err = h.Do(req, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryHandlerObservability(t *testing.T) {
+	m := &mockHandler{func(req *http.Request, _ any) (*Response, error) {
+		resp := fakeResponse(t, 502, "", false)
+		resp.Response.Request = req
+		return resp, fmt.Errorf("%w %d", ErrStatusCode, 502)
+	}}
+
+	type retry struct {
+		attempt int
+		err     string
+		backoff time.Duration
+	}
+	retries := []retry{}
+
+	registry := prometheus.NewRegistry()
+	h := wrapRetryHandler(m, RetryOpts{
+		BackoffFunc: func(retries int) time.Duration { return time.Duration(retries) * time.Millisecond },
+		MaxRetries:  2,
+		Policy:      DefaultRetryPolicy,
+		OnRetry: func(_ *http.Request, attempt int, err error, backoff time.Duration) {
+			retries = append(retries, retry{attempt, err.Error(), backoff})
+		},
+	}, instrumentation.New("api", registry).RetryMetrics())
+
+	client := NewClient(WithToken("dummy"))
+	ctx := ctxutil.SetOpPath(context.Background(), "/servers/%d")
+	req, err := client.NewRequest(ctx, "GET", "/servers/42", nil)
+	require.NoError(t, err)
+
+	_, err = h.Do(req, nil)
+	require.EqualError(t, err, "server responded with status code 502")
+
+	assert.Equal(t, []retry{
+		{1, "server responded with status code 502", 0},
+		{2, "server responded with status code 502", time.Millisecond},
+	}, retries)
+
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP hcloud_api_retries_exhausted_total A counter for requests to the hcloud api that exhausted their retries per endpoint.
+# TYPE hcloud_api_retries_exhausted_total counter
+hcloud_api_retries_exhausted_total{api_endpoint="/servers/-"} 1
+# HELP hcloud_api_retries_total A counter for retried requests to the hcloud api per endpoint and reason.
+# TYPE hcloud_api_retries_total counter
+hcloud_api_retries_total{api_endpoint="/servers/-",reason="http_502"} 2
+`)))
+}
+
+func TestRetryReason(t *testing.T) {
+	assert.Equal(t, "conflict", retryReason(nil, ErrorFromSchema(schema.Error{Code: string(ErrorCodeConflict)})))
+	assert.Equal(t, "http_504", retryReason(fakeResponse(t, 504, "", false), fmt.Errorf("%w %d", ErrStatusCode, 504)))
+	assert.Equal(t, "network_timeout", retryReason(&Response{}, &net.OpError{Err: os.ErrDeadlineExceeded}))
+	assert.Equal(t, "other", retryReason(nil, fmt.Errorf("random error")))
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
 )
 
 type mockHandler struct {
@@ -61,3 +63,68 @@ func TestCloneRequest(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Hello", string(reqBody))
 }
+
+func TestWithMiddleware(t *testing.T) {
+	ctx, server, _ := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/",
+			Status:  503,
+			JSONRaw: `{"error": {"code": "bad_gateway", "message": "Bad Gateway"}}`,
+		},
+		{
+			Method: "GET", Path: "/",
+			Want: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, "signed", r.Header.Get("X-Signature"))
+			},
+			Status:  200,
+			JSONRaw: `{"data": "Hello"}`,
+		},
+	})
+
+	calls := []string{}
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return HandlerFunc(func(req *http.Request, v any) (*Response, error) {
+				calls = append(calls, name)
+				resp, err := next.Do(req, v)
+				if err != nil {
+					calls = append(calls, name+": "+err.Error())
+				}
+				return resp, err
+			})
+		}
+	}
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithRetryOpts(RetryOpts{BackoffFunc: ConstantBackoff(0), MaxRetries: 5}),
+		WithMiddleware(MiddlewarePositionClient, record("client 1"), record("client 2")),
+		WithMiddleware(MiddlewarePositionAttempt, record("attempt")),
+		WithMiddleware(MiddlewarePositionTransport, record("transport"), func(next Handler) Handler {
+			return HandlerFunc(func(req *http.Request, v any) (*Response, error) {
+				req.Header.Set("X-Signature", "signed")
+				return next.Do(req, v)
+			})
+		}),
+	)
+
+	req, err := client.NewRequest(ctx, "GET", "/", nil)
+	require.NoError(t, err)
+
+	var body struct{ Data string }
+	_, err = client.Do(req, &body)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", body.Data)
+
+	assert.Equal(t, []string{
+		"client 1",
+		"client 2",
+		"attempt",
+		"transport",
+		"attempt: Bad Gateway (bad_gateway)",
+		"attempt",
+		"transport",
+	}, calls)
+}
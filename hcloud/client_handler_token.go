@@ -0,0 +1,99 @@
+package hcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/net/http/httpguts"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/envutil"
+)
+
+// A TokenSource returns the token used to authenticate the API requests.
+//
+// Token is called before every request, implementations should cache the token when
+// retrieving it is expensive. When the API responds with [ErrorCodeUnauthorized], Token
+// is called once more, and the request is retried if the returned token changed.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of ordinary functions as [TokenSource].
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// EnvTokenSource returns a [TokenSource] which reads the token from the environment
+// variable named by the key (e.g. HCLOUD_TOKEN), or from the file located by the
+// environment variable named by the key + '_FILE' (e.g. HCLOUD_TOKEN_FILE).
+//
+// The file is read on every request, which allows rotating the token without
+// restarting the process.
+func EnvTokenSource(key string) TokenSource {
+	return TokenSourceFunc(func(_ context.Context) (string, error) {
+		return envutil.LookupEnvWithFile(key)
+	})
+}
+
+// WithTokenSource configures a Client to use the token returned by the source for
+// authentication. It takes precedence over [WithToken].
+func WithTokenSource(source TokenSource) ClientOption {
+	return func(client *Client) {
+		client.tokenSource = source
+	}
+}
+
+func wrapTokenSourceHandler(wrapped Handler, source TokenSource) Handler {
+	return &tokenSourceHandler{wrapped, source}
+}
+
+type tokenSourceHandler struct {
+	handler Handler
+	source  TokenSource
+}
+
+func (h *tokenSourceHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	ctx := req.Context()
+
+	token, err := h.source.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("hcloud: failed to get token: %w", err)
+	}
+
+	// Keep a copy of the request, in case we need to retry it with a new token.
+	cloned, err := cloneRequest(req, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = h.do(req, v, token)
+	if !IsError(err, ErrorCodeUnauthorized) {
+		return resp, err
+	}
+
+	refreshed, refreshErr := h.source.Token(ctx)
+	if refreshErr != nil || refreshed == token {
+		return resp, err
+	}
+
+	return h.do(cloned, v, refreshed)
+}
+
+func (h *tokenSourceHandler) do(req *http.Request, v any, token string) (*Response, error) {
+	if !httpguts.ValidHeaderFieldValue(token) {
+		return nil, errors.New("authorization token contains invalid characters")
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else {
+		req.Header.Del("Authorization")
+	}
+
+	return h.handler.Do(req, v)
+}
@@ -0,0 +1,144 @@
+package hcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestTokenSourceHandler(t *testing.T) {
+	unauthorized := func(req *http.Request, _ any) (*Response, error) {
+		if req.Header.Get("Authorization") != "Bearer new" {
+			return nil, ErrorFromSchema(schema.Error{Code: string(ErrorCodeUnauthorized), Message: "Unauthorized"})
+		}
+		return fakeResponse(t, 200, "", false), nil
+	}
+
+	testCases := []struct {
+		name    string
+		tokens  []string
+		err     error
+		wrapped func(req *http.Request, v any) (*Response, error)
+		want    func(t *testing.T, err error, calls int)
+	}{
+		{
+			name:    "valid token",
+			tokens:  []string{"new"},
+			wrapped: unauthorized,
+			want: func(t *testing.T, err error, calls int) {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, calls)
+			},
+		},
+		{
+			name:    "rotated token",
+			tokens:  []string{"old", "new"},
+			wrapped: unauthorized,
+			want: func(t *testing.T, err error, calls int) {
+				assert.NoError(t, err)
+				assert.Equal(t, 2, calls)
+			},
+		},
+		{
+			name:    "unchanged token",
+			tokens:  []string{"old", "old"},
+			wrapped: unauthorized,
+			want: func(t *testing.T, err error, calls int) {
+				assert.EqualError(t, err, "Unauthorized (unauthorized)")
+				assert.Equal(t, 1, calls)
+			},
+		},
+		{
+			name:   "invalid token",
+			tokens: []string{"invalid\n"},
+			want: func(t *testing.T, err error, calls int) {
+				assert.EqualError(t, err, "authorization token contains invalid characters")
+				assert.Equal(t, 0, calls)
+			},
+		},
+		{
+			name: "token source error",
+			err:  fmt.Errorf("vault is sealed"),
+			want: func(t *testing.T, err error, calls int) {
+				assert.EqualError(t, err, "hcloud: failed to get token: vault is sealed")
+				assert.Equal(t, 0, calls)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			calls := 0
+			m := &mockHandler{func(req *http.Request, v any) (*Response, error) {
+				calls++
+				return testCase.wrapped(req, v)
+			}}
+
+			tokens := testCase.tokens
+			h := wrapTokenSourceHandler(m, TokenSourceFunc(func(_ context.Context) (string, error) {
+				if testCase.err != nil {
+					return "", testCase.err
+				}
+				token := tokens[0]
+				tokens = tokens[1:]
+				return token, nil
+			}))
+
+			client := NewClient()
+			req, err := client.NewRequest(context.Background(), "GET", "/", nil)
+			require.NoError(t, err)
+
+			_, err = h.Do(req, nil)
+			testCase.want(t, err, calls)
+		})
+	}
+}
+
+func TestClientWithTokenSource(t *testing.T) {
+	ctx, server, _ := makeTestUtils(t)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("first\n"), 0o600))
+	t.Setenv("HCLOUD_TEST_TOKEN_FILE", tokenFile)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/servers/1",
+			Want: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, "Bearer first", r.Header.Get("Authorization"))
+			},
+			Status: 200,
+			JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 1}},
+		},
+		{
+			Method: "GET", Path: "/servers/1",
+			Want: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, "Bearer second", r.Header.Get("Authorization"))
+			},
+			Status: 200,
+			JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 1}},
+		},
+	})
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithToken("static"),
+		WithTokenSource(EnvTokenSource("HCLOUD_TEST_TOKEN")),
+	)
+
+	_, _, err := client.Server.GetByID(ctx, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(tokenFile, []byte("second\n"), 0o600))
+
+	_, _, err = client.Server.GetByID(ctx, 1)
+	require.NoError(t, err)
+}
@@ -0,0 +1,96 @@
+package hcloud
+
+import (
+	"errors"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+)
+
+// tracerName is the instrumentation scope name of the tracer.
+const tracerName = "github.com/hetznercloud/hcloud-go/v2/hcloud"
+
+// WithTracerProvider configures a Client to create OpenTelemetry spans for the API
+// requests and the long running operations (e.g. [ActionClient.WaitFor]).
+//
+// A span is created for every API request, named after the request method and the
+// operation path (e.g. "GET /servers/-"). Retries are recorded as events of the span.
+func WithTracerProvider(provider trace.TracerProvider) ClientOption {
+	return func(client *Client) {
+		client.tracer = provider.Tracer(tracerName, trace.WithInstrumentationVersion(Version))
+		client.tracingEnabled = true
+	}
+}
+
+func wrapTracingHandler(wrapped Handler, tracer trace.Tracer) Handler {
+	return &tracingHandler{wrapped, tracer}
+}
+
+// tracingHandler creates a span covering all the attempts of a request.
+type tracingHandler struct {
+	handler Handler
+	tracer  trace.Tracer
+}
+
+func (h *tracingHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	opPath := ctxutil.OpPath(req.Context())
+	if opPath == "" {
+		opPath = req.URL.Path
+	}
+
+	ctx, span := h.tracer.Start(req.Context(), req.Method+" "+opPath,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("hcloud.op_path", opPath),
+		),
+	)
+	defer span.End()
+
+	resp, err = h.handler.Do(req.WithContext(ctx), v)
+
+	if resp != nil && resp.Response != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if correlationID := resp.internalCorrelationID(); correlationID != "" {
+			span.SetAttributes(attribute.String("hcloud.correlation_id", correlationID))
+		}
+	}
+
+	if err != nil {
+		var apiErr Error
+		if errors.As(err, &apiErr) {
+			span.SetAttributes(attribute.String("hcloud.error.code", string(apiErr.Code)))
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return resp, err
+}
+
+func wrapTracingAttemptHandler(wrapped Handler) Handler {
+	return &tracingAttemptHandler{wrapped}
+}
+
+// tracingAttemptHandler records the attempts of a request in the span created by the
+// [tracingHandler].
+type tracingAttemptHandler struct {
+	handler Handler
+}
+
+func (h *tracingAttemptHandler) Do(req *http.Request, v any) (resp *Response, err error) {
+	span := trace.SpanFromContext(req.Context())
+
+	attempt := attemptFromContext(req.Context())
+	if attempt > 0 {
+		span.AddEvent("retry", trace.WithAttributes(attribute.Int("hcloud.retry.attempt", attempt)))
+	}
+	span.SetAttributes(attribute.Int("hcloud.retry.count", attempt))
+
+	return h.handler.Do(req, v)
+}
@@ -0,0 +1,175 @@
+package hcloud
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestWithTracerProvider(t *testing.T) {
+	ctx, server, _ := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "POST", Path: "/servers/1/actions/poweron",
+			Status:  409,
+			JSONRaw: `{"error": {"code": "conflict", "message": "Conflict"}}`,
+		},
+		{
+			Method: "POST", Path: "/servers/1/actions/poweron",
+			Status: 201,
+			JSON: schema.ActionGetResponse{
+				Action: schema.Action{ID: 10, Status: "running", Command: "start_server"},
+			},
+		},
+		{
+			Method: "GET", Path: "/actions?id=10&page=1&sort=status&sort=id",
+			Status: 200,
+			JSON: schema.ActionListResponse{
+				Actions: []schema.Action{{ID: 10, Status: "success", Command: "start_server"}},
+			},
+		},
+		{
+			Method: "GET", Path: "/servers/2",
+			Status:  404,
+			JSONRaw: `{"error": {"code": "not_found", "message": "Server not found"}}`,
+		},
+	})
+
+	recorder := &spanRecorder{}
+
+	client := NewClient(
+		WithEndpoint(server.URL),
+		WithRetryOpts(RetryOpts{BackoffFunc: ConstantBackoff(0), MaxRetries: 5}),
+		WithPollOpts(PollOpts{BackoffFunc: ConstantBackoff(0)}),
+		WithTracerProvider(recorder),
+	)
+
+	action, _, err := client.Server.Poweron(ctx, &Server{ID: 1})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	_, _, err = client.Server.GetByID(ctx, 2)
+	require.NoError(t, err)
+
+	spans := recorder.ended
+	require.Len(t, spans, 4)
+
+	// Request with a retry
+	assert.Equal(t, "POST /servers/-/actions/poweron", spans[0].name)
+	assert.Contains(t, spans[0].attributes, attribute.Int("http.response.status_code", 201))
+	assert.Contains(t, spans[0].attributes, attribute.Int("hcloud.retry.count", 1))
+	require.Len(t, spans[0].events, 1)
+	assert.Equal(t, "retry", spans[0].events[0].Name)
+
+	// Polling request is a child of the wait span
+	assert.Equal(t, "GET /actions", spans[1].name)
+	assert.Equal(t, "hcloud.action.wait", spans[2].name)
+	assert.Equal(t, spans[2].spanContext.SpanID(), spans[1].parent.SpanID())
+	require.Len(t, spans[2].events, 1)
+	assert.Equal(t, "action status changed", spans[2].events[0].Name)
+	assert.Contains(t, spans[2].events[0].Attributes, attribute.String("hcloud.action.status", "success"))
+	assert.Contains(t, spans[2].events[0].Attributes, attribute.String("hcloud.action.previous_status", "running"))
+
+	// Request with an error
+	assert.Equal(t, "GET /servers/-", spans[3].name)
+	assert.Contains(t, spans[3].attributes, attribute.String("hcloud.error.code", "not_found"))
+	assert.Contains(t, spans[3].attributes, attribute.Int("hcloud.retry.count", 0))
+	assert.Equal(t, codes.Error, spans[3].status)
+}
+
+// spanRecorder is a [trace.TracerProvider] recording the ended spans, which keeps the
+// OpenTelemetry SDK out of the dependencies of the module.
+type spanRecorder struct {
+	embedded.TracerProvider
+
+	mu     sync.Mutex
+	lastID uint64
+	ended  []*recordedSpan
+}
+
+type recordingTracer struct {
+	embedded.Tracer
+
+	recorder *spanRecorder
+}
+
+type recordedSpan struct {
+	noop.Span
+
+	recorder    *spanRecorder
+	name        string
+	spanContext trace.SpanContext
+	parent      trace.SpanContext
+	attributes  []attribute.KeyValue
+	events      []recordedEvent
+	status      codes.Code
+}
+
+type recordedEvent struct {
+	Name       string
+	Attributes []attribute.KeyValue
+}
+
+func (r *spanRecorder) Tracer(_ string, _ ...trace.TracerOption) trace.Tracer {
+	return &recordingTracer{recorder: r}
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	r := t.recorder
+	r.mu.Lock()
+	r.lastID++
+	id := r.lastID
+	r.mu.Unlock()
+
+	parent := trace.SpanContextFromContext(ctx)
+
+	config := trace.SpanContextConfig{TraceID: parent.TraceID()}
+	if !parent.IsValid() {
+		binary.BigEndian.PutUint64(config.TraceID[8:], id)
+	}
+	binary.BigEndian.PutUint64(config.SpanID[:], id)
+
+	startConfig := trace.NewSpanStartConfig(opts...)
+	span := &recordedSpan{
+		recorder:    r,
+		name:        name,
+		spanContext: trace.NewSpanContext(config),
+		parent:      parent,
+		attributes:  startConfig.Attributes(),
+	}
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+func (s *recordedSpan) SpanContext() trace.SpanContext { return s.spanContext }
+
+func (s *recordedSpan) IsRecording() bool { return true }
+
+func (s *recordedSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.attributes = append(s.attributes, kv...)
+}
+
+func (s *recordedSpan) AddEvent(name string, opts ...trace.EventOption) {
+	config := trace.NewEventConfig(opts...)
+	s.events = append(s.events, recordedEvent{name, config.Attributes()})
+}
+
+func (s *recordedSpan) SetStatus(code codes.Code, _ string) { s.status = code }
+
+func (s *recordedSpan) End(_ ...trace.SpanEndOption) {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.recorder.ended = append(s.recorder.ended, s)
+}
@@ -2,7 +2,15 @@ package hcloud
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net"
+	"net/http"
 	"strconv"
+	"sync"
+	"time"
 )
 
 // allFromSchemaFunc transform each item in the list using the FromSchema function, and
@@ -17,7 +25,11 @@ func allFromSchemaFunc[T, V any](all []T, fn func(T) V) []V {
 }
 
 // iterPages fetches each pages using the list function, and returns the result.
-func iterPages[T any](listFn func(int) ([]*T, *Response, error)) ([]*T, error) {
+//
+// When concurrency is greater than 1 and the first response reports the last page, the
+// remaining pages are fetched concurrently using [fetchPages]. The list function must
+// therefore be safe for concurrent use.
+func iterPages[T any](concurrency int, listFn func(int) ([]*T, *Response, error)) ([]*T, error) {
 	page := 1
 	result := []*T{}
 
@@ -29,11 +41,106 @@ func iterPages[T any](listFn func(int) ([]*T, *Response, error)) ([]*T, error) {
 
 		result = append(result, pageResult...)
 
-		if resp.Meta.Pagination == nil || resp.Meta.Pagination.NextPage == 0 {
+		pagination := resp.Meta.Pagination
+		if pagination == nil || pagination.NextPage == 0 {
 			return result, nil
 		}
-		page = resp.Meta.Pagination.NextPage
+
+		if concurrency > 1 && pagination.LastPage > pagination.NextPage {
+			pagesResult, err := fetchPages(concurrency, pagination.NextPage, pagination.LastPage, resp.Meta.Ratelimit, listFn)
+			if err != nil {
+				return nil, err
+			}
+			return append(result, pagesResult...), nil
+		}
+		page = pagination.NextPage
+	}
+}
+
+// fetchPages fetches the pages from first to last concurrently using the list function,
+// and returns the results in the page order.
+//
+// The number of concurrent requests is bounded by the concurrency and by the remaining
+// rate limit of the latest response. After a page failed, no further pages are
+// requested, and the error of the lowest failed page is returned.
+func fetchPages[T any](
+	concurrency, first, last int,
+	ratelimit Ratelimit,
+	listFn func(int) ([]*T, *Response, error),
+) ([]*T, error) {
+	results := make([][]*T, last-first+1)
+	errs := make([]error, last-first+1)
+
+	runConcurrently(concurrency, len(results), ratelimit, func(i int) (*Response, bool) {
+		pageResult, resp, err := listFn(first + i)
+		results[i], errs[i] = pageResult, err
+		return resp, err == nil
+	})
+
+	result := []*T{}
+	for i := range results {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		result = append(result, results[i]...)
+	}
+	return result, nil
+}
+
+// runConcurrently calls fn with each index from 0 to n-1, with up to concurrency calls
+// running in parallel.
+//
+// The number of concurrent calls is further bounded by the remaining rate limit of the
+// latest response, starting with the given rate limit. Once a call returned false, no
+// further calls are started.
+func runConcurrently(concurrency, n int, ratelimit Ratelimit, fn func(i int) (resp *Response, ok bool)) {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		cond     = sync.NewCond(&mu)
+		inflight int
+		failed   bool
+	)
+
+	limit := func() int {
+		if ratelimit.Limit == 0 {
+			return max(1, concurrency)
+		}
+		return max(1, min(concurrency, ratelimit.Remaining))
+	}
+
+	mu.Lock()
+	for i := range n {
+		for !failed && inflight >= limit() {
+			cond.Wait()
+		}
+		if failed {
+			break
+		}
+
+		inflight++
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, ok := fn(i)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			inflight--
+			if !ok {
+				failed = true
+			}
+			if resp != nil && resp.Meta.Ratelimit.Limit > 0 {
+				ratelimit = resp.Meta.Ratelimit
+			}
+			cond.Broadcast()
+		}()
 	}
+	mu.Unlock()
+
+	wg.Wait()
 }
 
 // firstBy fetches a list of items using the list function, and returns the first item
@@ -83,3 +190,104 @@ func getByIDOrName[T any](
 
 	return getByNameFn(ctx, idOrName)
 }
+
+// iterPagesSeq returns an iterator over the items of each pages, fetched lazily using
+// the list function. The iteration stops after yielding an error.
+func iterPagesSeq[T any](ctx context.Context, listFn func(int) ([]*T, *Response, error)) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		page := 1
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			pageResult, resp, err := listFn(page)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, item := range pageResult {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if resp.Meta.Pagination == nil || resp.Meta.Pagination.NextPage == 0 {
+				return
+			}
+			page = resp.Meta.Pagination.NextPage
+		}
+	}
+}
+
+// waitUntil fetches the resource using the get function at the interval defined by
+// [WithPollOpts], until the condition holds or returns an error. The description is used
+// in the errors, e.g. "server 42".
+//
+// When the resource does not exist, an [Error] with [ErrorCodeNotFound] is returned.
+// Temporary errors while fetching the resource (see [temporaryError]) do not end the
+// wait, the resource is fetched again at the next interval.
+func waitUntil[T any](
+	ctx context.Context,
+	client *Client,
+	description string,
+	getFn func(ctx context.Context) (*T, *Response, error),
+	condition func(*T) (bool, error),
+) (*T, error) {
+	var resource *T
+	for retries := 0; ; retries++ {
+		current, resp, err := getFn(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return resource, fmt.Errorf("%s did not reach the expected state: %w", description, ctx.Err())
+		case err != nil:
+			if !temporaryError(resp, err) {
+				return resource, err
+			}
+		case current == nil:
+			return nil, Error{Code: ErrorCodeNotFound, Message: description + " not found"}
+		default:
+			resource = current
+
+			done, err := condition(resource)
+			if err != nil {
+				return resource, err
+			}
+			if done {
+				return resource, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return resource, fmt.Errorf("%s did not reach the expected state: %w", description, ctx.Err())
+		case <-time.After(client.pollBackoffFunc(retries)):
+		}
+	}
+}
+
+// temporaryError returns whether the error of a request is known to be temporary, and
+// the request may succeed when sent again: API errors such as [ErrorCodeServiceError]
+// or [ErrorCodeTimeout], HTTP 5xx responses and network errors.
+func temporaryError(resp *Response, err error) bool {
+	var netErr net.Error
+
+	switch {
+	case IsError(err,
+		ErrorCodeServiceError,
+		ErrorCodeRateLimitExceeded,
+		ErrorCodeBadGateway,
+		ErrorCodeTimeout,
+		ErrorCodeConflict,
+	):
+		return true
+	case errors.Is(err, ErrStatusCode):
+		return resp != nil && resp.Response != nil && resp.StatusCode >= http.StatusInternalServerError
+	case errors.As(err, &netErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	default:
+		return false
+	}
+}
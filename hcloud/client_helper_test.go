@@ -1,15 +1,22 @@
 package hcloud
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
 )
 
 func TestIterPages(t *testing.T) {
 	t.Run("succeed", func(t *testing.T) {
-		result, err := iterPages(func(page int) ([]*int, *Response, error) {
+		result, err := iterPages(1, func(page int) ([]*int, *Response, error) {
 			if page < 4 {
 				return []*int{Ptr(page)}, &Response{Meta: Meta{Pagination: &Pagination{NextPage: page + 1}}}, nil
 			}
@@ -20,7 +27,7 @@ func TestIterPages(t *testing.T) {
 	})
 
 	t.Run("failed", func(t *testing.T) {
-		result, err := iterPages(func(page int) ([]*int, *Response, error) {
+		result, err := iterPages(1, func(page int) ([]*int, *Response, error) {
 			if page < 4 {
 				return []*int{Ptr(page)}, &Response{Meta: Meta{Pagination: &Pagination{NextPage: page + 1}}}, nil
 			}
@@ -30,3 +37,285 @@ func TestIterPages(t *testing.T) {
 		require.Nil(t, result)
 	})
 }
+
+func TestIterPagesConcurrency(t *testing.T) {
+	pagination := func(page int) *Pagination {
+		p := &Pagination{Page: page, LastPage: 6}
+		if page < 6 {
+			p.NextPage = page + 1
+		}
+		return p
+	}
+
+	t.Run("succeed", func(t *testing.T) {
+		var mu sync.Mutex
+		inflight, maxInflight := 0, 0
+
+		result, err := iterPages(3, func(page int) ([]*int, *Response, error) {
+			mu.Lock()
+			inflight++
+			maxInflight = max(maxInflight, inflight)
+			mu.Unlock()
+
+			// Finish the pages in reverse order
+			time.Sleep(time.Duration(6-page) * time.Millisecond)
+
+			mu.Lock()
+			inflight--
+			mu.Unlock()
+
+			return []*int{Ptr(page)}, &Response{Meta: Meta{Pagination: pagination(page)}}, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []*int{Ptr(1), Ptr(2), Ptr(3), Ptr(4), Ptr(5), Ptr(6)}, result)
+		require.Equal(t, 3, maxInflight)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		var mu sync.Mutex
+		inflight, maxInflight := 0, 0
+
+		result, err := iterPages(3, func(page int) ([]*int, *Response, error) {
+			mu.Lock()
+			inflight++
+			maxInflight = max(maxInflight, inflight)
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			inflight--
+			mu.Unlock()
+
+			return []*int{Ptr(page)}, &Response{Meta: Meta{
+				Pagination: pagination(page),
+				Ratelimit:  Ratelimit{Limit: 3600, Remaining: 1},
+			}}, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []*int{Ptr(1), Ptr(2), Ptr(3), Ptr(4), Ptr(5), Ptr(6)}, result)
+		require.Equal(t, 1, maxInflight)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		result, err := iterPages(6, func(page int) ([]*int, *Response, error) {
+			switch page {
+			case 3:
+				// Fail after page 5, the error of the lowest page must be returned
+				time.Sleep(5 * time.Millisecond)
+				return nil, &Response{}, fmt.Errorf("failure %d", page)
+			case 5:
+				return nil, &Response{}, fmt.Errorf("failure %d", page)
+			}
+			return []*int{Ptr(page)}, &Response{Meta: Meta{Pagination: pagination(page)}}, nil
+		})
+		require.EqualError(t, err, "failure 3")
+		require.Nil(t, result)
+	})
+}
+
+func TestIterPagesSeq(t *testing.T) {
+	listFn := func(calls *int) func(page int) ([]*int, *Response, error) {
+		return func(page int) ([]*int, *Response, error) {
+			*calls++
+			if page < 4 {
+				return []*int{Ptr(page)}, &Response{Meta: Meta{Pagination: &Pagination{NextPage: page + 1}}}, nil
+			}
+			return []*int{Ptr(page)}, &Response{}, nil
+		}
+	}
+
+	t.Run("succeed", func(t *testing.T) {
+		calls := 0
+		result := []*int{}
+		for item, err := range iterPagesSeq(context.Background(), listFn(&calls)) {
+			require.NoError(t, err)
+			result = append(result, item)
+		}
+		require.Equal(t, []*int{Ptr(1), Ptr(2), Ptr(3), Ptr(4)}, result)
+		require.Equal(t, 4, calls)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		calls := 0
+		for item, err := range iterPagesSeq(context.Background(), listFn(&calls)) {
+			require.NoError(t, err)
+			if *item == 2 {
+				break
+			}
+		}
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		result := []*int{}
+		var errs []error
+		for item, err := range iterPagesSeq(context.Background(), func(page int) ([]*int, *Response, error) {
+			if page < 2 {
+				return []*int{Ptr(page)}, &Response{Meta: Meta{Pagination: &Pagination{NextPage: page + 1}}}, nil
+			}
+			return nil, &Response{}, fmt.Errorf("failure")
+		}) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			result = append(result, item)
+		}
+		require.Equal(t, []*int{Ptr(1)}, result)
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], "failure")
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		calls := 0
+		var errs []error
+		for item, err := range iterPagesSeq(ctx, listFn(&calls)) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if *item == 2 {
+				cancel()
+			}
+		}
+		require.Equal(t, 2, calls)
+		require.Len(t, errs, 1)
+		require.ErrorIs(t, errs[0], context.Canceled)
+	})
+}
+
+func TestWaitUntil(t *testing.T) {
+	t.Run("succeed", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/servers/42",
+				Status: 200,
+				JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 42, Status: "starting"}},
+			},
+			{
+				Method: "GET", Path: "/servers/42",
+				Status: 200,
+				JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 42, Status: "running"}},
+			},
+		})
+
+		result, err := client.Server.WaitUntil(ctx, &Server{ID: 42}, ServerIsRunning)
+		require.NoError(t, err)
+		assert.Equal(t, ServerStatusRunning, result.Status)
+	})
+
+	t.Run("temporary error", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/servers/42",
+				Status: 200,
+				JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 42, Status: "starting"}},
+			},
+			{
+				Method: "GET", Path: "/servers/42",
+				Status: 503,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeServiceError), Message: "Service error"}},
+			},
+			{
+				Method: "GET", Path: "/servers/42",
+				Status: 502,
+			},
+			{
+				Method: "GET", Path: "/servers/42",
+				Status: 200,
+				JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 42, Status: "running"}},
+			},
+		})
+
+		result, err := client.Server.WaitUntil(ctx, &Server{ID: 42}, ServerIsRunning)
+		require.NoError(t, err)
+		assert.Equal(t, ServerStatusRunning, result.Status)
+	})
+
+	t.Run("permanent error", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/servers/42",
+				Status: 403,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeForbidden), Message: "Forbidden"}},
+			},
+		})
+
+		_, err := client.Server.WaitUntil(ctx, &Server{ID: 42}, ServerIsRunning)
+		require.EqualError(t, err, "Forbidden (forbidden)")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/zones/example.com",
+				Status: 404,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeNotFound)}},
+			},
+		})
+
+		_, err := client.Zone.WaitUntil(ctx, &Zone{Name: "example.com"}, ZoneIsDelegated)
+		require.EqualError(t, err, "zone example.com not found (not_found)")
+		require.True(t, IsError(err, ErrorCodeNotFound))
+	})
+
+	t.Run("condition failed", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/certificates/1",
+				Status: 200,
+				JSON: schema.CertificateGetResponse{Certificate: schema.Certificate{
+					ID:   1,
+					Type: "managed",
+					Status: &schema.CertificateStatusRef{
+						Issuance: "failed",
+						Error:    &schema.Error{Code: "dns_zone_not_found", Message: "DNS zone not found"},
+					},
+				}},
+			},
+		})
+
+		result, err := client.Certificate.WaitUntil(ctx, &Certificate{ID: 1}, CertificateIsIssued)
+		require.EqualError(t, err, "DNS zone not found (dns_zone_not_found)")
+		require.NotNil(t, result)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx, server, _ := makeTestUtils(t)
+		client := NewClient(
+			WithEndpoint(server.URL),
+			WithHetznerEndpoint(server.URL),
+			WithPollOpts(PollOpts{BackoffFunc: ConstantBackoff(time.Second)}),
+		)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/storage_boxes/3",
+				Status: 200,
+				JSON:   schema.StorageBoxGetResponse{StorageBox: schema.StorageBox{ID: 3, Status: "initializing"}},
+			},
+		})
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		result, err := client.StorageBox.WaitUntil(ctx, &StorageBox{ID: 3}, StorageBoxIsInitialized)
+		require.EqualError(t, err, "storage box 3 did not reach the expected state: context deadline exceeded")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, StorageBoxStatusInitializing, result.Status)
+	})
+}
@@ -0,0 +1,144 @@
+package hcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"slices"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/randutil"
+)
+
+// IdempotencyKeyLabel is the label used to tag the resources created in the idempotent
+// create mode, see [WithIdempotentCreate].
+const IdempotencyKeyLabel = "hcloud-go/idempotency-key"
+
+// WithIdempotentCreate configures a Client to create servers, volumes and storage boxes
+// idempotently.
+//
+// The created resources are tagged with the [IdempotencyKeyLabel] label, holding a
+// generated key. When it is unclear whether a failed create request reached the API
+// (e.g. on network errors, timeouts or HTTP 5xx responses), the resource is looked up
+// by its label before the request is retried, and the existing resource is returned
+// instead of creating a second one. The lookup costs an additional list request, and
+// only happens when the request is about to be retried. A key may be provided in the labels of the create
+// options, e.g. to continue an interrupted creation.
+//
+// The create requests are retried according to the [RetryOpts] of the Client. When an
+// existing resource is returned, the create result only holds the resource and its
+// create action (e.g. the root password of a server is empty), and the returned
+// [Response] is nil.
+func WithIdempotentCreate() ClientOption {
+	return func(client *Client) {
+		client.idempotentCreate = true
+	}
+}
+
+// errCreatedByPreviousAttempt is returned by the retry handler when a previous attempt
+// of an idempotent create request created the resource.
+var errCreatedByPreviousAttempt = errors.New("resource created by a previous attempt")
+
+// createLookupError is returned when the lookup of an idempotent create request failed.
+// It unwraps to the error of the create request only, the lookup error is merely
+// described in the message.
+type createLookupError struct {
+	err       error
+	lookupErr error
+}
+
+func (e createLookupError) Error() string {
+	return fmt.Sprintf("%s (lookup of the created resource failed: %s)", e.err, e.lookupErr)
+}
+
+func (e createLookupError) Unwrap() error {
+	return e.err
+}
+
+// idempotentCreate creates a resource tagged with an idempotency key. Before the retry
+// handler retries a request that may have created the resource, the resource is looked
+// up by its idempotency key.
+func idempotentCreate[T any](
+	ctx context.Context,
+	labels map[string]string,
+	create func(ctx context.Context, labels map[string]string) (T, *Response, error),
+	lookup func(ctx context.Context, labelSelector string) (T, bool, error),
+) (T, *Response, error) {
+	labels = maps.Clone(labels)
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	if labels[IdempotencyKeyLabel] == "" {
+		labels[IdempotencyKeyLabel] = randutil.GenerateID()
+	}
+	labelSelector := IdempotencyKeyLabel + "=" + labels[IdempotencyKeyLabel]
+
+	var found T
+	createCtx := withCreateLookup(ctx, func() (bool, error) {
+		// The lookup requests use the original context, they must not trigger lookups
+		// themselves.
+		var ok bool
+		var err error
+		found, ok, err = lookup(ctx, labelSelector)
+		return ok, err
+	})
+
+	result, resp, err := create(createCtx, labels)
+	if errors.Is(err, errCreatedByPreviousAttempt) {
+		return found, nil, nil
+	}
+	return result, resp, err
+}
+
+// ambiguousCreateError returns whether a failed create request may have created the
+// resource.
+func ambiguousCreateError(resp *Response, err error, retries int) bool {
+	var apiErr Error
+	var netErr net.Error
+
+	switch {
+	case errors.As(err, &apiErr):
+		switch apiErr.Code { //nolint:exhaustive
+		case ErrorCodeTimeout, ErrorCodeBadGateway:
+			return true
+		case ErrorCodeUniquenessError:
+			// A previous attempt may have created the resource with the same name.
+			return retries > 0
+		}
+	case errors.Is(err, ErrStatusCode):
+		return resp != nil && resp.Response != nil && resp.StatusCode >= 500
+	case errors.As(err, &netErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	}
+	return false
+}
+
+// findCreateAction returns the action with the given command, or nil if not found.
+func findCreateAction(actions []*Action, command string) *Action {
+	i := slices.IndexFunc(actions, func(a *Action) bool { return a.Command == command })
+	if i < 0 {
+		return nil
+	}
+	return actions[i]
+}
+
+// createLookupKey is the key for the idempotent create lookup in Contexts.
+type createLookupKey struct{}
+
+// createLookup looks up the resource of an idempotent create request, and returns
+// whether it exists.
+type createLookup func() (bool, error)
+
+// withCreateLookup returns a copy of ctx holding the lookup of an idempotent create
+// request.
+func withCreateLookup(ctx context.Context, lookup createLookup) context.Context {
+	return context.WithValue(ctx, createLookupKey{}, lookup)
+}
+
+// createLookupFromContext returns the lookup of an idempotent create request, or nil.
+func createLookupFromContext(ctx context.Context) createLookup {
+	lookup, _ := ctx.Value(createLookupKey{}).(createLookup)
+	return lookup
+}
@@ -0,0 +1,318 @@
+package hcloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func makeIdempotentTestUtils(t *testing.T, requests []mockutil.Request, options ...ClientOption) (context.Context, *Client) {
+	server := mockutil.NewServer(t, requests)
+
+	client := NewClient(append([]ClientOption{
+		WithEndpoint(server.URL),
+		WithHetznerEndpoint(server.URL),
+		WithRetryOpts(RetryOpts{BackoffFunc: ConstantBackoff(0), MaxRetries: 5}),
+		WithIdempotentCreate(),
+	}, options...)...)
+
+	return context.Background(), client
+}
+
+func wantIdempotencyKey(key string) func(t *testing.T, r *http.Request) {
+	return func(t *testing.T, r *http.Request) {
+		body := struct {
+			Labels map[string]string `json:"labels"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "bar", body.Labels["foo"])
+		if key == "" {
+			assert.Len(t, body.Labels[IdempotencyKeyLabel], 8)
+		} else {
+			assert.Equal(t, key, body.Labels[IdempotencyKeyLabel])
+		}
+	}
+}
+
+func TestIdempotentCreate(t *testing.T) {
+	t.Run("server found after gateway timeout", func(t *testing.T) {
+		ctx, client := makeIdempotentTestUtils(t, []mockutil.Request{
+			{
+				Method: "POST", Path: "/servers",
+				Want:    wantIdempotencyKey("key"),
+				Status:  504,
+				TextRaw: "gateway timeout",
+			},
+			{
+				Method: "GET", Path: "/servers?label_selector=hcloud-go%2Fidempotency-key%3Dkey",
+				Status: 200,
+				JSON: schema.ServerListResponse{
+					Servers: []schema.Server{{ID: 1, Name: "my-server"}},
+				},
+			},
+			{
+				Method: "GET", Path: "/servers/1/actions?page=1",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{ID: 2, Command: "start_server"},
+						{ID: 1, Command: "create_server"},
+					},
+				},
+			},
+		})
+
+		opts := ServerCreateOpts{
+			Name:       "my-server",
+			ServerType: &ServerType{Name: "cpx22"},
+			Image:      &Image{Name: "debian-13"},
+			Labels:     map[string]string{"foo": "bar", IdempotencyKeyLabel: "key"},
+		}
+		result, resp, err := client.Server.Create(ctx, opts)
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, int64(1), result.Server.ID)
+		require.NotNil(t, result.Action)
+		assert.Equal(t, int64(1), result.Action.ID)
+
+		// The labels of the options are left untouched.
+		assert.Len(t, opts.Labels, 2)
+	})
+
+	t.Run("volume created after gateway timeout", func(t *testing.T) {
+		retries := make([]int, 0)
+		ctx, client := makeIdempotentTestUtils(t, []mockutil.Request{
+			{
+				Method: "POST", Path: "/volumes",
+				Status:  504,
+				TextRaw: "gateway timeout",
+			},
+			{
+				Method: "GET", Path: "/volumes?label_selector=hcloud-go%2Fidempotency-key%3Dkey",
+				Status: 200,
+				JSON:   schema.VolumeListResponse{Volumes: []schema.Volume{}},
+			},
+			{
+				Method: "POST", Path: "/volumes",
+				Want:   wantIdempotencyKey("key"),
+				Status: 201,
+				JSON: schema.VolumeCreateResponse{
+					Volume: schema.Volume{ID: 1},
+					Action: &schema.Action{ID: 1},
+				},
+			},
+		}, WithRetryOpts(RetryOpts{
+			MaxRetries: 5,
+			OnRetry: func(_ *http.Request, attempt int, _ error, _ time.Duration) {
+				retries = append(retries, attempt)
+			},
+		}))
+
+		result, resp, err := client.Volume.Create(ctx, VolumeCreateOpts{
+			Name:     "my-volume",
+			Size:     10,
+			Location: &Location{Name: "fsn1"},
+			Labels:   map[string]string{"foo": "bar", IdempotencyKeyLabel: "key"},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, int64(1), result.Volume.ID)
+		assert.Equal(t, int64(1), result.Action.ID)
+		assert.Equal(t, []int{1}, retries)
+	})
+
+	t.Run("retry policy", func(t *testing.T) {
+		ctx, client := makeIdempotentTestUtils(t, []mockutil.Request{
+			{
+				Method: "POST", Path: "/volumes",
+				Status:  504,
+				TextRaw: "gateway timeout",
+			},
+			// No lookup, the request is not retried.
+		}, WithRetryOpts(RetryOpts{
+			MaxRetries: 5,
+			Policy:     func(_ *http.Request, _ *Response, _ error) (bool, time.Duration) { return false, 0 },
+		}))
+
+		_, _, err := client.Volume.Create(ctx, VolumeCreateOpts{
+			Name:     "my-volume",
+			Size:     10,
+			Location: &Location{Name: "fsn1"},
+			Labels:   map[string]string{IdempotencyKeyLabel: "key"},
+		})
+		require.ErrorIs(t, err, ErrStatusCode)
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		ctx, client := makeIdempotentTestUtils(t, []mockutil.Request{
+			{
+				Method: "POST", Path: "/volumes",
+				Status: 502,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeBadGateway)}},
+			},
+			// No lookup, the request is not retried.
+		}, WithRetryOpts(RetryOpts{MaxRetries: 0}))
+
+		_, _, err := client.Volume.Create(ctx, VolumeCreateOpts{
+			Name:     "my-volume",
+			Size:     10,
+			Location: &Location{Name: "fsn1"},
+			Labels:   map[string]string{IdempotencyKeyLabel: "key"},
+		})
+		require.True(t, IsError(err, ErrorCodeBadGateway))
+	})
+
+	t.Run("generated key", func(t *testing.T) {
+		ctx, client := makeIdempotentTestUtils(t, []mockutil.Request{
+			{
+				Method: "POST", Path: "/volumes",
+				Want:   wantIdempotencyKey(""),
+				Status: 201,
+				JSON:   schema.VolumeCreateResponse{Volume: schema.Volume{ID: 1}},
+			},
+		})
+
+		result, _, err := client.Volume.Create(ctx, VolumeCreateOpts{
+			Name:     "my-volume",
+			Size:     10,
+			Location: &Location{Name: "fsn1"},
+			Labels:   map[string]string{"foo": "bar"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.Volume.ID)
+	})
+
+	t.Run("storage box retried after conflict", func(t *testing.T) {
+		ctx, client := makeIdempotentTestUtils(t, []mockutil.Request{
+			{
+				Method: "POST", Path: "/storage_boxes",
+				Status: 409,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeConflict)}},
+			},
+			{
+				Method: "POST", Path: "/storage_boxes",
+				Status: 201,
+				JSON: schema.StorageBoxCreateResponse{
+					StorageBox: schema.StorageBox{ID: 1},
+					Action:     schema.Action{ID: 1},
+				},
+			},
+		})
+
+		result, _, err := client.StorageBox.Create(ctx, StorageBoxCreateOpts{
+			Name:           "my-storage-box",
+			StorageBoxType: &StorageBoxType{Name: "bx11"},
+			Location:       &Location{Name: "fsn1"},
+			Password:       "secret",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.StorageBox.ID)
+	})
+
+	t.Run("uniqueness error on retry", func(t *testing.T) {
+		ctx, client := makeIdempotentTestUtils(t, []mockutil.Request{
+			{
+				Method: "POST", Path: "/volumes",
+				Status: 502,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeBadGateway)}},
+			},
+			{
+				Method: "GET", Path: "/volumes?label_selector=hcloud-go%2Fidempotency-key%3Dkey",
+				Status: 200,
+				JSON:   schema.VolumeListResponse{Volumes: []schema.Volume{}},
+			},
+			{
+				Method: "POST", Path: "/volumes",
+				Status: 409,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeUniquenessError)}},
+			},
+			{
+				Method: "GET", Path: "/volumes?label_selector=hcloud-go%2Fidempotency-key%3Dkey",
+				Status: 200,
+				JSON:   schema.VolumeListResponse{Volumes: []schema.Volume{{ID: 1}}},
+			},
+			{
+				Method: "GET", Path: "/volumes/1/actions?page=1",
+				Status: 200,
+				JSON:   schema.ActionListResponse{Actions: []schema.Action{}},
+			},
+		})
+
+		result, _, err := client.Volume.Create(ctx, VolumeCreateOpts{
+			Name:     "my-volume",
+			Size:     10,
+			Location: &Location{Name: "fsn1"},
+			Labels:   map[string]string{IdempotencyKeyLabel: "key"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.Volume.ID)
+		assert.Nil(t, result.Action)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		ctx, client := makeIdempotentTestUtils(t, []mockutil.Request{
+			{
+				Method: "POST", Path: "/volumes",
+				Status: 400,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeInvalidInput)}},
+			},
+		})
+
+		_, _, err := client.Volume.Create(ctx, VolumeCreateOpts{Name: "my-volume", Size: 10, Location: &Location{Name: "fsn1"}})
+		require.True(t, IsError(err, ErrorCodeInvalidInput))
+	})
+
+	t.Run("lookup failed", func(t *testing.T) {
+		ctx, client := makeIdempotentTestUtils(t, []mockutil.Request{
+			{
+				Method: "POST", Path: "/volumes",
+				Status: 504,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeTimeout)}},
+			},
+			{
+				Method: "GET", Path: "/volumes?label_selector=hcloud-go%2Fidempotency-key%3Dkey",
+				Status: 403,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeForbidden), Message: "insufficient permissions"}},
+			},
+		})
+
+		_, _, err := client.Volume.Create(ctx, VolumeCreateOpts{
+			Name:     "my-volume",
+			Size:     10,
+			Location: &Location{Name: "fsn1"},
+			Labels:   map[string]string{IdempotencyKeyLabel: "key"},
+		})
+		require.True(t, IsError(err, ErrorCodeTimeout))
+		require.False(t, IsError(err, ErrorCodeForbidden))
+		require.ErrorContains(t, err, "insufficient permissions")
+	})
+}
+
+func TestAmbiguousCreateError(t *testing.T) {
+	apiError := func(code ErrorCode) error {
+		return ErrorFromSchema(schema.Error{Code: string(code)})
+	}
+
+	assert.True(t, ambiguousCreateError(nil, &net.OpError{Op: "read", Err: errors.New("connection reset")}, 0))
+	assert.True(t, ambiguousCreateError(nil, io.ErrUnexpectedEOF, 0))
+	assert.True(t, ambiguousCreateError(nil, apiError(ErrorCodeTimeout), 0))
+	assert.True(t, ambiguousCreateError(fakeResponse(t, 500, "", false), fmt.Errorf("%w %d", ErrStatusCode, 500), 0))
+	assert.True(t, ambiguousCreateError(nil, apiError(ErrorCodeUniquenessError), 1))
+
+	assert.False(t, ambiguousCreateError(nil, apiError(ErrorCodeUniquenessError), 0))
+	assert.False(t, ambiguousCreateError(nil, apiError(ErrorCodeConflict), 0))
+	assert.False(t, ambiguousCreateError(fakeResponse(t, 404, "", false), fmt.Errorf("%w %d", ErrStatusCode, 404), 0))
+	assert.False(t, ambiguousCreateError(nil, errors.New("invalid request"), 0))
+}
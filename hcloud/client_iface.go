@@ -0,0 +1,105 @@
+package hcloud
+
+// IClient is the interface of the resource clients of a [Client].
+//
+// Code may depend on IClient rather than on the concrete [Client] type, e.g. to use
+// the mock implementations of the [github.com/hetznercloud/hcloud-go/v2/hcloud/mock]
+// package in tests.
+type IClient interface {
+	ActionClient() IActionClient
+	CertificateClient() ICertificateClient
+	FirewallClient() IFirewallClient
+	FloatingIPClient() IFloatingIPClient
+	ImageClient() IImageClient
+	ISOClient() IISOClient
+	LoadBalancerClient() ILoadBalancerClient
+	LoadBalancerTypeClient() ILoadBalancerTypeClient
+	LocationClient() ILocationClient
+	NetworkClient() INetworkClient
+	PricingClient() IPricingClient
+	ServerClient() IServerClient
+	ServerTypeClient() IServerTypeClient
+	StorageBoxClient() IStorageBoxClient
+	SSHKeyClient() ISSHKeyClient
+	VolumeClient() IVolumeClient
+	PlacementGroupClient() IPlacementGroupClient
+	RDNSClient() IRDNSClient
+	PrimaryIPClient() IPrimaryIPClient
+	StorageBoxTypeClient() IStorageBoxTypeClient
+	ZoneClient() IZoneClient
+
+	// Deprecated: [DatacenterClient] is deprecated and will be removed after the 2026-10-01. See
+	// https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
+	DatacenterClient() IDatacenterClient
+}
+
+var _ IClient = (*Client)(nil)
+
+// ActionClient returns the [Client.Action] client.
+func (c *Client) ActionClient() IActionClient { return &c.Action }
+
+// CertificateClient returns the [Client.Certificate] client.
+func (c *Client) CertificateClient() ICertificateClient { return &c.Certificate }
+
+// FirewallClient returns the [Client.Firewall] client.
+func (c *Client) FirewallClient() IFirewallClient { return &c.Firewall }
+
+// FloatingIPClient returns the [Client.FloatingIP] client.
+func (c *Client) FloatingIPClient() IFloatingIPClient { return &c.FloatingIP }
+
+// ImageClient returns the [Client.Image] client.
+func (c *Client) ImageClient() IImageClient { return &c.Image }
+
+// ISOClient returns the [Client.ISO] client.
+func (c *Client) ISOClient() IISOClient { return &c.ISO }
+
+// LoadBalancerClient returns the [Client.LoadBalancer] client.
+func (c *Client) LoadBalancerClient() ILoadBalancerClient { return &c.LoadBalancer }
+
+// LoadBalancerTypeClient returns the [Client.LoadBalancerType] client.
+func (c *Client) LoadBalancerTypeClient() ILoadBalancerTypeClient { return &c.LoadBalancerType }
+
+// LocationClient returns the [Client.Location] client.
+func (c *Client) LocationClient() ILocationClient { return &c.Location }
+
+// NetworkClient returns the [Client.Network] client.
+func (c *Client) NetworkClient() INetworkClient { return &c.Network }
+
+// PricingClient returns the [Client.Pricing] client.
+func (c *Client) PricingClient() IPricingClient { return &c.Pricing }
+
+// ServerClient returns the [Client.Server] client.
+func (c *Client) ServerClient() IServerClient { return &c.Server }
+
+// ServerTypeClient returns the [Client.ServerType] client.
+func (c *Client) ServerTypeClient() IServerTypeClient { return &c.ServerType }
+
+// StorageBoxClient returns the [Client.StorageBox] client.
+func (c *Client) StorageBoxClient() IStorageBoxClient { return &c.StorageBox }
+
+// SSHKeyClient returns the [Client.SSHKey] client.
+func (c *Client) SSHKeyClient() ISSHKeyClient { return &c.SSHKey }
+
+// VolumeClient returns the [Client.Volume] client.
+func (c *Client) VolumeClient() IVolumeClient { return &c.Volume }
+
+// PlacementGroupClient returns the [Client.PlacementGroup] client.
+func (c *Client) PlacementGroupClient() IPlacementGroupClient { return &c.PlacementGroup }
+
+// RDNSClient returns the [Client.RDNS] client.
+func (c *Client) RDNSClient() IRDNSClient { return &c.RDNS }
+
+// PrimaryIPClient returns the [Client.PrimaryIP] client.
+func (c *Client) PrimaryIPClient() IPrimaryIPClient { return &c.PrimaryIP }
+
+// StorageBoxTypeClient returns the [Client.StorageBoxType] client.
+func (c *Client) StorageBoxTypeClient() IStorageBoxTypeClient { return &c.StorageBoxType }
+
+// ZoneClient returns the [Client.Zone] client.
+func (c *Client) ZoneClient() IZoneClient { return &c.Zone }
+
+// DatacenterClient returns the [Client.Datacenter] client.
+//
+// Deprecated: [DatacenterClient] is deprecated and will be removed after the 2026-10-01. See
+// https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
+func (c *Client) DatacenterClient() IDatacenterClient { return &c.Datacenter }
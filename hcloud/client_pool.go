@@ -0,0 +1,82 @@
+package hcloud
+
+import (
+	"net/http"
+	"sync"
+)
+
+// ClientPool hands out a [Client] per project, that only differ in their credentials.
+//
+// All clients share the same configuration, HTTP connection pool, and Prometheus
+// registry. When [WithInstrumentation] is configured, the metrics of each client have
+// a "project" label. The Prometheus registry must therefore not be shared with
+// clients created outside the pool.
+type ClientPool struct {
+	options    []ClientOption
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	clients map[string]*clientPoolEntry
+}
+
+type clientPoolEntry struct {
+	token  string
+	client *Client
+}
+
+// NewClientPool creates a new client pool. The options are used to configure every
+// client of the pool.
+func NewClientPool(options ...ClientOption) *ClientPool {
+	// Only used to retrieve the configured http client.
+	base := &Client{httpClient: &http.Client{}}
+	for _, option := range options {
+		option(base)
+	}
+
+	return &ClientPool{
+		options:    options,
+		httpClient: base.httpClient,
+		clients:    make(map[string]*clientPoolEntry),
+	}
+}
+
+// Client returns the client for the project, authenticated with the given token. The
+// client is created on first use, and re-created when the token changes.
+func (p *ClientPool) Client(project, token string) *Client {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if entry, ok := p.clients[project]; ok && entry.token == token {
+		return entry.client
+	}
+
+	// Each client must have its own http client, as the instrumentation wraps its
+	// transport. The transport, which holds the connection pool, is shared.
+	httpClient := *p.httpClient
+
+	options := append(p.options[:len(p.options):len(p.options)],
+		WithHTTPClient(&httpClient),
+		WithToken(token),
+		withProject(project),
+	)
+
+	client := NewClient(options...)
+	p.clients[project] = &clientPoolEntry{token: token, client: client}
+
+	return client
+}
+
+// Remove removes the client of the project from the pool.
+func (p *ClientPool) Remove(project string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.clients, project)
+}
+
+// withProject configures a Client to add the project label to its metrics.
+func withProject(project string) ClientOption {
+	return func(client *Client) {
+		client.project = project
+	}
+}
@@ -0,0 +1,81 @@
+package hcloud
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestClientPool(t *testing.T) {
+	ctx, server, _ := makeTestUtils(t)
+
+	wantToken := func(token string) func(t *testing.T, r *http.Request) {
+		return func(t *testing.T, r *http.Request) {
+			assert.Equal(t, "Bearer "+token, r.Header.Get("Authorization"))
+		}
+	}
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/servers/1",
+			Want:   wantToken("token-a"),
+			Status: 200,
+			JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 1}},
+		},
+		{
+			Method: "GET", Path: "/servers/2",
+			Want:   wantToken("token-b"),
+			Status: 200,
+			JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 2}},
+		},
+		{
+			Method: "GET", Path: "/servers/2",
+			Want:   wantToken("token-c"),
+			Status: 200,
+			JSON:   schema.ServerGetResponse{Server: schema.Server{ID: 2}},
+		},
+	})
+
+	transport := &http.Transport{}
+	registry := prometheus.NewRegistry()
+
+	pool := NewClientPool(
+		WithEndpoint(server.URL),
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithInstrumentation(registry),
+	)
+
+	clientA := pool.Client("a", "token-a")
+	clientB := pool.Client("b", "token-b")
+	assert.Same(t, clientA, pool.Client("a", "token-a"))
+	assert.NotSame(t, clientA, clientB)
+
+	// Each client has its own http client, wrapping the shared transport
+	assert.NotSame(t, clientA.httpClient, clientB.httpClient)
+
+	_, _, err := clientA.Server.GetByID(ctx, 1)
+	require.NoError(t, err)
+	_, _, err = clientB.Server.GetByID(ctx, 2)
+	require.NoError(t, err)
+
+	// The client is re-created when the token changes
+	clientC := pool.Client("b", "token-c")
+	assert.NotSame(t, clientB, clientC)
+	_, _, err = clientC.Server.GetByID(ctx, 2)
+	require.NoError(t, err)
+
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP hcloud_api_requests_total A counter for requests to the hcloud api per endpoint.
+# TYPE hcloud_api_requests_total counter
+hcloud_api_requests_total{api_endpoint="/servers/-",code="200",method="get",project="a"} 1
+hcloud_api_requests_total{api_endpoint="/servers/-",code="200",method="get",project="b"} 2
+`), "hcloud_api_requests_total"))
+}
@@ -84,6 +84,19 @@ func TestClientEndpointTrailingSlashesRemoved(t *testing.T) {
 	}
 }
 
+func TestWithRetryOpts(t *testing.T) {
+	onRetry := func(_ *http.Request, _ int, _ error, _ time.Duration) {}
+
+	client := NewClient(
+		WithRetryOpts(RetryOpts{MaxRetries: 5, OnRetry: onRetry}),
+		WithRetryOpts(RetryOpts{MaxRetries: 3}),
+	)
+	assert.Equal(t, 3, client.retryMaxRetries)
+	assert.NotNil(t, client.retryBackoffFunc)
+	assert.NotNil(t, client.retryPolicy)
+	assert.NotNil(t, client.retryOnRetry)
+}
+
 func TestClientError(t *testing.T) {
 	env := newTestEnv()
 	defer env.Teardown()
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 
@@ -48,6 +49,10 @@ type DatacenterClient struct {
 // Deprecated: [DatacenterClient.GetByID] is deprecated and will be removed after the 2026-10-01. See
 // https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
 func (c *DatacenterClient) GetByID(ctx context.Context, id int64) (*Datacenter, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.datacenters.find(ctx, func(d *Datacenter) bool { return d.ID == id })
+	}
+
 	const opPath = "/datacenters/%d"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
@@ -69,6 +74,10 @@ func (c *DatacenterClient) GetByID(ctx context.Context, id int64) (*Datacenter,
 // Deprecated: [DatacenterClient.GetByName] is deprecated and will be removed after the 2026-10-01. See
 // https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
 func (c *DatacenterClient) GetByName(ctx context.Context, name string) (*Datacenter, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil && name != "" {
+		return cache.datacenters.find(ctx, func(d *Datacenter) bool { return d.Name == name })
+	}
+
 	return firstByName(name, func() ([]*Datacenter, *Response, error) {
 		return c.List(ctx, DatacenterListOpts{Name: name})
 	})
@@ -133,6 +142,10 @@ func (c *DatacenterClient) List(ctx context.Context, opts DatacenterListOpts) ([
 // Deprecated: [DatacenterClient.All] is deprecated and will be removed after the 2026-10-01. See
 // https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
 func (c *DatacenterClient) All(ctx context.Context) ([]*Datacenter, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.datacenters.all(ctx)
+	}
+
 	return c.AllWithOpts(ctx, DatacenterListOpts{})
 }
 
@@ -144,7 +157,23 @@ func (c *DatacenterClient) AllWithOpts(ctx context.Context, opts DatacenterListO
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Datacenter, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Datacenter, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all datacenters for the given options.
+// The pages are fetched lazily while iterating.
+//
+// Deprecated: [DatacenterClient.Iter] is deprecated and will be removed after the 2026-10-01. See
+// https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
+func (c *DatacenterClient) Iter(ctx context.Context, opts DatacenterListOpts) iter.Seq2[*Datacenter, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Datacenter, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -0,0 +1,113 @@
+package actionutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// Reporter reports the updates of actions, for example to a terminal or a logger.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Reporter interface {
+	Report(update hcloud.ActionUpdate)
+}
+
+// Watch waits until all actions are completed, and reports their updates to the
+// reporter. The errors of the failed actions are returned as [hcloud.ActionError].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func Watch(ctx context.Context, client *hcloud.ActionClient, reporter Reporter, actions ...*hcloud.Action) error {
+	var errs []error
+	for update, err := range client.Updates(ctx, actions...) {
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		reporter.Report(update)
+
+		if update.Action.Status == hcloud.ActionStatusError {
+			errs = append(errs, update.Action.Error())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+const progressBarWidth = 40
+
+// ProgressBar renders the overall progress of the actions as a terminal progress bar,
+// for example:
+//
+//	[====================                    ]  50% 12s
+//
+// The bar is redrawn on the same line for every update, and the line is terminated once
+// the actions are completed.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type ProgressBar struct {
+	w io.Writer
+}
+
+// NewProgressBar returns a [ProgressBar] writing to w, usually [os.Stderr].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewProgressBar(w io.Writer) *ProgressBar {
+	return &ProgressBar{w: w}
+}
+
+// Report redraws the progress bar.
+func (b *ProgressBar) Report(update hcloud.ActionUpdate) {
+	filled := progressBarWidth * update.OverallProgress / 100
+
+	fmt.Fprintf(b.w, "\r[%s%s] %3d%% %s",
+		strings.Repeat("=", filled),
+		strings.Repeat(" ", progressBarWidth-filled),
+		update.OverallProgress,
+		update.Elapsed.Round(time.Second),
+	)
+	if update.OverallProgress == 100 {
+		fmt.Fprintln(b.w)
+	}
+}
+
+// SlogReporter logs the updates of the actions using a [slog.Logger]. Failed actions
+// are logged at [slog.LevelError], other updates at [slog.LevelInfo].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type SlogReporter struct {
+	logger *slog.Logger
+}
+
+// NewSlogReporter returns a [SlogReporter] using the logger.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewSlogReporter(logger *slog.Logger) *SlogReporter {
+	return &SlogReporter{logger: logger}
+}
+
+// Report logs the update.
+func (r *SlogReporter) Report(update hcloud.ActionUpdate) {
+	level := slog.LevelInfo
+	attrs := []slog.Attr{
+		slog.Int64("id", update.Action.ID),
+		slog.String("command", update.Action.Command),
+		slog.String("status", string(update.Action.Status)),
+		slog.String("previous_status", string(update.PreviousStatus)),
+		slog.Int("progress", update.Action.Progress),
+		slog.Int("overall_progress", update.OverallProgress),
+		slog.Duration("elapsed", update.Elapsed),
+	}
+	if err := update.Action.Error(); err != nil {
+		level = slog.LevelError
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	r.logger.LogAttrs(context.Background(), level, "action updated", attrs...)
+}
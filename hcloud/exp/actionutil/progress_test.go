@@ -0,0 +1,103 @@
+package actionutil
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+)
+
+type recorder struct {
+	updates []hcloud.ActionUpdate
+}
+
+func (r *recorder) Report(update hcloud.ActionUpdate) {
+	r.updates = append(r.updates, update)
+}
+
+func TestWatch(t *testing.T) {
+	server := mockutil.NewServer(t, []mockutil.Request{
+		{
+			Method: "GET", Path: "/actions?id=1&id=2&page=1&sort=status&sort=id",
+			Status: 200,
+			JSONRaw: `{
+				"actions": [
+					{ "id": 1, "command": "create_server", "status": "success", "progress": 100 },
+					{ "id": 2, "command": "start_server", "status": "error", "progress": 100,
+						"error": { "code": "action_failed", "message": "Action failed" }}
+				]
+			}`,
+		},
+	})
+
+	client := hcloud.NewClient(
+		hcloud.WithEndpoint(server.URL),
+		hcloud.WithPollOpts(hcloud.PollOpts{BackoffFunc: hcloud.ConstantBackoff(0)}),
+	)
+
+	reporter := &recorder{}
+	err := Watch(context.Background(), &client.Action, reporter,
+		&hcloud.Action{ID: 1, Status: hcloud.ActionStatusRunning},
+		&hcloud.Action{ID: 2, Status: hcloud.ActionStatusRunning},
+	)
+	require.EqualError(t, err, "Action failed (action_failed, 2)")
+	require.Len(t, reporter.updates, 2)
+	assert.Equal(t, 100, reporter.updates[1].OverallProgress)
+}
+
+func TestProgressBar(t *testing.T) {
+	buf := &bytes.Buffer{}
+	bar := NewProgressBar(buf)
+
+	bar.Report(hcloud.ActionUpdate{Action: &hcloud.Action{ID: 1}, OverallProgress: 50, Elapsed: 12300 * time.Millisecond})
+	bar.Report(hcloud.ActionUpdate{Action: &hcloud.Action{ID: 1}, OverallProgress: 100, Elapsed: 20 * time.Second})
+
+	assert.Equal(t,
+		"\r[====================                    ]  50% 12s"+
+			"\r[========================================] 100% 20s\n",
+		buf.String(),
+	)
+}
+
+func TestSlogReporter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	reporter := NewSlogReporter(slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+
+	reporter.Report(hcloud.ActionUpdate{
+		Action:          &hcloud.Action{ID: 1, Command: "create_server", Status: hcloud.ActionStatusRunning, Progress: 40},
+		PreviousStatus:  hcloud.ActionStatusRunning,
+		ProgressDelta:   40,
+		OverallProgress: 40,
+		Elapsed:         2 * time.Second,
+	})
+	reporter.Report(hcloud.ActionUpdate{
+		Action: &hcloud.Action{
+			ID: 1, Command: "create_server", Status: hcloud.ActionStatusError, Progress: 100,
+			ErrorCode: "action_failed", ErrorMessage: "Action failed",
+		},
+		PreviousStatus:  hcloud.ActionStatusRunning,
+		ProgressDelta:   60,
+		OverallProgress: 100,
+		Elapsed:         5 * time.Second,
+	})
+
+	assert.Equal(t,
+		`level=INFO msg="action updated" id=1 command=create_server status=running previous_status=running progress=40 overall_progress=40 elapsed=2s`+"\n"+
+			`level=ERROR msg="action updated" id=1 command=create_server status=error previous_status=running progress=100 overall_progress=100 elapsed=5s error="Action failed (action_failed, 1)"`+"\n",
+		buf.String(),
+	)
+}
@@ -0,0 +1,206 @@
+package fakeapi
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+type action struct {
+	schema.Action
+	// done is called when the action completes, to apply its effect on the resources.
+	done func()
+	// errorCode fails the action with the error code instead of completing it.
+	errorCode hcloud.ErrorCode
+}
+
+type actionStore struct {
+	server *Server
+	items  []*action
+	nextID int64
+}
+
+func newActionStore(server *Server) *actionStore {
+	return &actionStore{server: server}
+}
+
+// start creates a running action for the resources. The done function is called when
+// the action completes.
+func (s *actionStore) start(command string, done func(), resources ...schema.ActionResourceReference) *action {
+	s.nextID++
+	a := &action{
+		Action: schema.Action{
+			ID:        s.nextID,
+			Status:    string(hcloud.ActionStatusRunning),
+			Command:   command,
+			Started:   s.server.opts.Now(),
+			Resources: resources,
+		},
+		done: done,
+	}
+	if a.Resources == nil {
+		a.Resources = []schema.ActionResourceReference{}
+	}
+	s.items = append(s.items, a)
+	return a
+}
+
+// complete completes the running actions that are due.
+func (s *actionStore) complete() {
+	now := s.server.opts.Now()
+	for _, a := range s.items {
+		if a.Status != string(hcloud.ActionStatusRunning) {
+			continue
+		}
+
+		finished := a.Started.Add(s.server.opts.ActionDelay)
+		if now.Before(finished) {
+			continue
+		}
+		if s.server.opts.ActionDelay == 0 {
+			finished = now
+		}
+
+		a.Finished = &finished
+		if a.errorCode != "" {
+			a.Status = string(hcloud.ActionStatusError)
+			a.Error = &schema.ActionError{Code: string(a.errorCode), Message: "injected fault"}
+			continue
+		}
+
+		a.Status = string(hcloud.ActionStatusSuccess)
+		a.Progress = 100
+		if a.done != nil {
+			a.done()
+		}
+	}
+}
+
+func (s *actionStore) get(id int64) *action {
+	i := slices.IndexFunc(s.items, func(a *action) bool { return a.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return s.items[i]
+}
+
+// list returns the actions matching the filter query parameters, and the resource
+// filter, in the order of the sort query parameters.
+func (s *actionStore) list(r *http.Request, match func(*action) bool) (response, error) {
+	query := r.URL.Query()
+
+	ids := make([]int64, 0, len(query["id"]))
+	for _, v := range query["id"] {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return response{}, errInvalidInput("invalid id: %s", v)
+		}
+		ids = append(ids, id)
+	}
+
+	result := make([]schema.Action, 0)
+	for _, a := range s.items {
+		if len(ids) > 0 && !slices.Contains(ids, a.ID) {
+			continue
+		}
+		if statuses := query["status"]; len(statuses) > 0 && !slices.Contains(statuses, a.Status) {
+			continue
+		}
+		if match != nil && !match(a) {
+			continue
+		}
+		result = append(result, a.Action)
+	}
+
+	if err := sortActions(result, query["sort"]); err != nil {
+		return response{}, err
+	}
+
+	page, meta, err := paginate(r, result)
+	if err != nil {
+		return response{}, err
+	}
+	return response{http.StatusOK, map[string]any{"actions": page, "meta": meta}}, nil
+}
+
+// actionSortFields holds the comparison functions of the fields the actions may be
+// sorted by.
+var actionSortFields = map[string]func(a, b schema.Action) int{
+	"id":      func(a, b schema.Action) int { return cmp.Compare(a.ID, b.ID) },
+	"command": func(a, b schema.Action) int { return strings.Compare(a.Command, b.Command) },
+	"status":  func(a, b schema.Action) int { return strings.Compare(a.Status, b.Status) },
+	"started": func(a, b schema.Action) int { return a.Started.Compare(b.Started) },
+	"finished": func(a, b schema.Action) int {
+		var aFinished, bFinished time.Time
+		if a.Finished != nil {
+			aFinished = *a.Finished
+		}
+		if b.Finished != nil {
+			bFinished = *b.Finished
+		}
+		return aFinished.Compare(bFinished)
+	},
+}
+
+// sortActions sorts the actions by the sort query parameters, e.g. "started:desc". The
+// actions keep their creation order when no sort is given.
+func sortActions(actions []schema.Action, sorts []string) error {
+	compares := make([]func(a, b schema.Action) int, 0, len(sorts))
+	for _, sort := range sorts {
+		field, order, _ := strings.Cut(sort, ":")
+
+		compare, ok := actionSortFields[field]
+		if !ok || (order != "" && order != "asc" && order != "desc") {
+			return errInvalidInput("invalid sort: %s", sort)
+		}
+		if order == "desc" {
+			asc := compare
+			compare = func(a, b schema.Action) int { return asc(b, a) }
+		}
+		compares = append(compares, compare)
+	}
+
+	slices.SortStableFunc(actions, func(a, b schema.Action) int {
+		for _, compare := range compares {
+			if c := compare(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+	return nil
+}
+
+func (s *Server) registerActions() {
+	s.handle("GET /actions", func(r *http.Request) (response, error) {
+		return s.actions.list(r, nil)
+	})
+
+	s.handle("GET /actions/{id}", func(r *http.Request) (response, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return response{}, err
+		}
+		a := s.actions.get(id)
+		if a == nil {
+			return response{}, errNotFound("action")
+		}
+		return response{http.StatusOK, schema.ActionGetResponse{Action: a.Action}}, nil
+	})
+}
+
+// actionResponse returns the response body of a request that started an action.
+func actionResponse(a *action) response {
+	return response{http.StatusCreated, schema.ActionGetResponse{Action: a.Action}}
+}
+
+// now returns the current time, truncated to the second like the API timestamps.
+func (s *Server) now() time.Time {
+	return s.opts.Now().UTC().Truncate(time.Second)
+}
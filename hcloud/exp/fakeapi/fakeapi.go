@@ -0,0 +1,258 @@
+// Package fakeapi provides a stateful, in-memory fake of the Hetzner Cloud API, to run
+// integration tests against.
+//
+// The fake implements the CRUD operations and the actions of servers, volumes,
+// networks, firewalls, load balancers, primary IPs, floating IPs, zones, RRSets and
+// storage boxes (without their snapshots and subaccounts). It assigns IDs, paginates,
+// filters by name and label selector, and completes the actions after a configurable
+// delay.
+//
+// The fake is served over HTTP, the client is configured to use its URL:
+//
+//	fake := fakeapi.New(fakeapi.Opts{})
+//	defer fake.Close()
+//
+//	client := hcloud.NewClient(
+//		hcloud.WithEndpoint(fake.URL),
+//		hcloud.WithHetznerEndpoint(fake.URL),
+//	)
+//
+// Failures may be injected into the requests using [Opts.Faults], e.g. to exercise the
+// retries of the client:
+//
+//	fake := fakeapi.New(fakeapi.Opts{
+//		Faults: []fakeapi.Fault{
+//			{Rate: 0.1, Code: hcloud.ErrorCodeRateLimitExceeded},
+//			{Method: "POST", Path: "/servers", ActionError: hcloud.ErrorCodePlacementError},
+//		},
+//	})
+//	defer fake.Close()
+//
+// Only the state of the resources is simulated, the fake does not validate the
+// references to catalog resources (e.g. server types or locations). Actions without a
+// simulated effect are accepted and completed.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+package fakeapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// Opts defines the options used by [New].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Opts struct {
+	// ActionDelay is the duration after which the actions complete. By default, the
+	// actions are returned as running by the request that started them, and are
+	// completed on the next request.
+	ActionDelay time.Duration
+	// Now returns the current time. Defaults to [time.Now].
+	Now func() time.Time
+
+	// Faults are injected into the matching requests, to test the handling of failures.
+	Faults []Fault
+	// Rand selects the requests the faults with a Rate are injected into. Defaults to a
+	// randomly seeded source.
+	Rand *rand.Rand
+}
+
+// Server is a fake Hetzner Cloud API server.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Server struct {
+	*httptest.Server
+
+	opts Opts
+	mux  *http.ServeMux
+
+	mu          sync.Mutex
+	rand        *rand.Rand
+	faultCounts []int
+
+	actions       *actionStore
+	servers       *kind[schema.Server]
+	volumes       *kind[schema.Volume]
+	networks      *kind[schema.Network]
+	firewalls     *kind[schema.Firewall]
+	loadBalancers *kind[schema.LoadBalancer]
+	primaryIPs    *kind[schema.PrimaryIP]
+	floatingIPs   *kind[schema.FloatingIP]
+	zones         *kind[schema.Zone]
+	rrsets        map[int64][]*schema.ZoneRRSet
+	storageBoxes  *kind[schema.StorageBox]
+}
+
+// New starts a new fake API server. The server must be closed when no longer used.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func New(opts Opts) *Server {
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if opts.Rand == nil {
+		opts.Rand = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())) // #nosec G404
+	}
+
+	s := &Server{
+		opts:        opts,
+		mux:         http.NewServeMux(),
+		rand:        opts.Rand,
+		faultCounts: make([]int, len(opts.Faults)),
+		rrsets:      make(map[int64][]*schema.ZoneRRSet),
+	}
+	s.actions = newActionStore(s)
+
+	s.registerActions()
+	s.registerServers()
+	s.registerVolumes()
+	s.registerNetworks()
+	s.registerFirewalls()
+	s.registerLoadBalancers()
+	s.registerPrimaryIPs()
+	s.registerFloatingIPs()
+	s.registerZones()
+	s.registerStorageBoxes()
+
+	s.Server = httptest.NewServer(s.injectFaults(s.mux))
+	return s
+}
+
+// apiError is an error returned by the API.
+type apiError struct {
+	status  int
+	code    hcloud.ErrorCode
+	message string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.message, e.code)
+}
+
+func newError(status int, code hcloud.ErrorCode, format string, args ...any) error {
+	return &apiError{status: status, code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func errNotFound(resource string) error {
+	return newError(http.StatusNotFound, hcloud.ErrorCodeNotFound, "%s not found", resource)
+}
+
+func errInvalidInput(format string, args ...any) error {
+	return newError(http.StatusBadRequest, hcloud.ErrorCodeInvalidInput, format, args...)
+}
+
+// response is the result of a request handler.
+type response struct {
+	status int
+	body   any
+}
+
+type handlerFunc func(r *http.Request) (response, error)
+
+// handle registers the handler for the pattern. The handlers are called sequentially,
+// after the due actions were completed.
+func (s *Server) handle(pattern string, handler handlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.actions.complete()
+		started := len(s.actions.items)
+		resp, err := handler(r)
+		if code := actionError(r); code != "" {
+			for _, a := range s.actions.items[started:] {
+				a.errorCode = code
+			}
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			apiErr := &apiError{}
+			if !errors.As(err, &apiErr) {
+				apiErr = &apiError{status: http.StatusInternalServerError, code: hcloud.ErrorCodeServerError, message: err.Error()}
+			}
+			writeJSON(w, apiErr.status, schema.ErrorResponse{Error: schema.Error{
+				Code:    string(apiErr.code),
+				Message: apiErr.message,
+			}})
+			return
+		}
+
+		if resp.body == nil {
+			w.WriteHeader(resp.status)
+			return
+		}
+		writeJSON(w, resp.status, resp.body)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decodeBody decodes the JSON request body into v.
+func decodeBody(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, hcloud.ErrorCodeJSONError, "invalid JSON: %s", err)
+	}
+	return nil
+}
+
+// pathID parses the ID in the request path.
+func pathID(r *http.Request, name string) (int64, error) {
+	id, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil {
+		return 0, errInvalidInput("invalid %s: %s", name, r.PathValue(name))
+	}
+	return id, nil
+}
+
+// paginate returns the items of the requested page, and the pagination metadata.
+func paginate[T any](r *http.Request, items []T) ([]T, schema.Meta, error) {
+	query := r.URL.Query()
+
+	page, perPage := 1, 25
+	if v := query.Get("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, schema.Meta{}, errInvalidInput("invalid page: %s", v)
+		}
+		page = n
+	}
+	if v := query.Get("per_page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, schema.Meta{}, errInvalidInput("invalid per_page: %s", v)
+		}
+		perPage = min(n, 50)
+	}
+
+	lastPage := max(1, (len(items)+perPage-1)/perPage)
+	pagination := &schema.MetaPagination{
+		Page:         page,
+		PerPage:      perPage,
+		LastPage:     lastPage,
+		TotalEntries: len(items),
+	}
+	if page > 1 {
+		pagination.PreviousPage = page - 1
+	}
+	if page < lastPage {
+		pagination.NextPage = page + 1
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	return items[start:end], schema.Meta{Pagination: pagination}, nil
+}
@@ -0,0 +1,281 @@
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func newClient(t *testing.T, opts Opts) (*Server, *hcloud.Client) {
+	t.Helper()
+
+	fake := New(opts)
+	t.Cleanup(fake.Close)
+
+	client := hcloud.NewClient(
+		hcloud.WithEndpoint(fake.URL),
+		hcloud.WithHetznerEndpoint(fake.URL),
+		hcloud.WithRetryOpts(hcloud.RetryOpts{BackoffFunc: hcloud.ConstantBackoff(0), MaxRetries: 3}),
+		hcloud.WithPollOpts(hcloud.PollOpts{BackoffFunc: hcloud.ConstantBackoff(0)}),
+	)
+	return fake, client
+}
+
+func mustParseCIDR(t *testing.T, s string) *net.IPNet {
+	t.Helper()
+	_, ipNet, err := net.ParseCIDR(s)
+	require.NoError(t, err)
+	return ipNet
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t, Opts{})
+
+	result, _, err := client.Server.Create(ctx, hcloud.ServerCreateOpts{
+		Name:       "web",
+		ServerType: &hcloud.ServerType{Name: "cpx22"},
+		Image:      &hcloud.Image{Name: "debian-13"},
+		Labels:     map[string]string{"env": "prod"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.Server.ID)
+	assert.Equal(t, hcloud.ServerStatusInitializing, result.Server.Status)
+	assert.Equal(t, hcloud.ActionStatusRunning, result.Action.Status)
+	assert.NotEmpty(t, result.Server.PublicNet.IPv4.IP)
+	assert.NotEmpty(t, result.RootPassword)
+
+	require.NoError(t, client.Action.WaitFor(ctx, result.Action))
+
+	server, _, err := client.Server.GetByName(ctx, "web")
+	require.NoError(t, err)
+	require.NotNil(t, server)
+	assert.Equal(t, hcloud.ServerStatusRunning, server.Status)
+
+	primaryIPs, err := client.PrimaryIP.All(ctx)
+	require.NoError(t, err)
+	require.Len(t, primaryIPs, 2)
+	assert.Equal(t, server.ID, primaryIPs[0].AssigneeID)
+
+	action, _, err := client.Server.Poweroff(ctx, server)
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	server, _, err = client.Server.GetByID(ctx, server.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.ServerStatusOff, server.Status)
+
+	actions, err := client.Server.Action.All(ctx, hcloud.ActionListOpts{})
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	assert.Equal(t, "create_server", actions[0].Command)
+	assert.Equal(t, "poweroff", actions[1].Command)
+
+	deleteResult, _, err := client.Server.DeleteWithResult(ctx, server)
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, deleteResult.Action))
+
+	server, _, err = client.Server.GetByID(ctx, server.ID)
+	require.NoError(t, err)
+	assert.Nil(t, server)
+
+	primaryIPs, err = client.PrimaryIP.All(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, primaryIPs)
+}
+
+func TestErrors(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t, Opts{})
+
+	volume, _, err := client.Volume.Create(ctx, hcloud.VolumeCreateOpts{
+		Name:     "data",
+		Size:     10,
+		Location: &hcloud.Location{Name: "fsn1"},
+	})
+	require.NoError(t, err)
+
+	_, _, err = client.Volume.Create(ctx, hcloud.VolumeCreateOpts{
+		Name:     "data",
+		Size:     10,
+		Location: &hcloud.Location{Name: "fsn1"},
+	})
+	assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeUniquenessError), err)
+
+	_, _, err = client.Volume.Attach(ctx, volume.Volume, &hcloud.Server{ID: 42})
+	assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeNotFound), err)
+
+	action, _, err := client.Volume.ChangeProtection(ctx, volume.Volume, hcloud.VolumeChangeProtectionOpts{Delete: hcloud.Ptr(true)})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	_, err = client.Volume.Delete(ctx, volume.Volume)
+	assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeProtected), err)
+
+	action, _, err = client.Volume.ChangeProtection(ctx, volume.Volume, hcloud.VolumeChangeProtectionOpts{Delete: hcloud.Ptr(false)})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	_, err = client.Volume.Delete(ctx, volume.Volume)
+	require.NoError(t, err)
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t, Opts{})
+
+	for i := range 60 {
+		env := "dev"
+		if i%3 == 0 {
+			env = "prod"
+		}
+		_, _, err := client.Network.Create(ctx, hcloud.NetworkCreateOpts{
+			Name:    fmt.Sprintf("network-%d", i),
+			IPRange: mustParseCIDR(t, "10.0.0.0/16"),
+			Labels:  map[string]string{"env": env},
+		})
+		require.NoError(t, err)
+	}
+
+	networks, resp, err := client.Network.List(ctx, hcloud.NetworkListOpts{ListOpts: hcloud.ListOpts{Page: 2, PerPage: 25}})
+	require.NoError(t, err)
+	require.Len(t, networks, 25)
+	assert.Equal(t, "network-25", networks[0].Name)
+	assert.Equal(t, 3, resp.Meta.Pagination.NextPage)
+	assert.Equal(t, 60, resp.Meta.Pagination.TotalEntries)
+
+	networks, err = client.Network.All(ctx)
+	require.NoError(t, err)
+	assert.Len(t, networks, 60)
+
+	networks, err = client.Network.AllWithOpts(ctx, hcloud.NetworkListOpts{ListOpts: hcloud.ListOpts{LabelSelector: "env=prod"}})
+	require.NoError(t, err)
+	assert.Len(t, networks, 20)
+
+	_, _, err = client.Network.List(ctx, hcloud.NetworkListOpts{ListOpts: hcloud.ListOpts{LabelSelector: "env in (prod"}})
+	assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeInvalidInput), err)
+}
+
+func TestActionDelay(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, client := newClient(t, Opts{
+		ActionDelay: time.Minute,
+		Now:         func() time.Time { return now },
+	})
+
+	result, _, err := client.Volume.Create(ctx, hcloud.VolumeCreateOpts{
+		Name:     "data",
+		Size:     10,
+		Location: &hcloud.Location{Name: "fsn1"},
+	})
+	require.NoError(t, err)
+
+	action, _, err := client.Action.GetByID(ctx, result.Action.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.ActionStatusRunning, action.Status)
+
+	now = now.Add(time.Minute)
+
+	action, _, err = client.Action.GetByID(ctx, result.Action.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.ActionStatusSuccess, action.Status)
+	assert.Equal(t, now, action.Finished)
+
+	volume, _, err := client.Volume.GetByID(ctx, result.Volume.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.VolumeStatusAvailable, volume.Status)
+}
+
+func TestActionQuery(t *testing.T) {
+	ctx := context.Background()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	_, client := newClient(t, Opts{
+		Now: func() time.Time { return now },
+	})
+
+	ids := make([]int64, 0, 3)
+	for i := range 3 {
+		now = start.Add(time.Duration(i) * time.Hour)
+
+		result, _, err := client.Volume.Create(ctx, hcloud.VolumeCreateOpts{
+			Name:     fmt.Sprintf("data-%d", i),
+			Size:     10,
+			Location: &hcloud.Location{Name: "fsn1"},
+		})
+		require.NoError(t, err)
+		ids = append(ids, result.Action.ID)
+	}
+
+	for _, query := range []hcloud.ActionQuery{
+		{Resource: &hcloud.ActionResource{Type: hcloud.ActionResourceTypeVolume}},
+		{ID: ids},
+	} {
+		query.StartedAfter = start.Add(30 * time.Minute)
+
+		result := make([]int64, 0)
+		for action, err := range client.Action.Query(ctx, query) {
+			require.NoError(t, err)
+			result = append(result, action.ID)
+		}
+		assert.Equal(t, []int64{ids[2], ids[1]}, result)
+	}
+
+	_, _, err := client.Action.List(ctx, hcloud.ActionListOpts{ID: ids, Sort: []string{"progress"}})
+	assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeInvalidInput), err)
+}
+
+func TestZone(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t, Opts{})
+
+	result, _, err := client.Zone.Create(ctx, hcloud.ZoneCreateOpts{
+		Name: "example.com",
+		Mode: hcloud.ZoneModePrimary,
+		RRSets: []hcloud.ZoneCreateOptsRRSet{
+			{Name: "www", Type: hcloud.ZoneRRSetTypeA, Records: []hcloud.ZoneRRSetRecord{{Value: "203.0.113.1"}}},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, result.Action))
+
+	zone, _, err := client.Zone.GetByName(ctx, "example.com")
+	require.NoError(t, err)
+	require.NotNil(t, zone)
+
+	rrsets, err := client.Zone.AllRRSets(ctx, zone)
+	require.NoError(t, err)
+	require.Len(t, rrsets, 3)
+	assert.Equal(t, "@/NS", rrsets[0].ID)
+	assert.Equal(t, "@/SOA", rrsets[1].ID)
+	assert.Equal(t, "www/A", rrsets[2].ID)
+
+	action, _, err := client.Zone.AddRRSetRecords(ctx, rrsets[2], hcloud.ZoneRRSetAddRecordsOpts{
+		Records: []hcloud.ZoneRRSetRecord{{Value: "203.0.113.2"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	rrset, _, err := client.Zone.GetRRSetByNameAndType(ctx, zone, "www", hcloud.ZoneRRSetTypeA)
+	require.NoError(t, err)
+	require.NotNil(t, rrset)
+	assert.Len(t, rrset.Records, 2)
+
+	deleteResult, _, err := client.Zone.DeleteRRSet(ctx, rrset)
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, deleteResult.Action))
+
+	rrset, _, err = client.Zone.GetRRSetByNameAndType(ctx, zone, "www", hcloud.ZoneRRSetTypeA)
+	require.NoError(t, err)
+	assert.Nil(t, rrset)
+}
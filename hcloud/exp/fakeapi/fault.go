@@ -0,0 +1,169 @@
+package fakeapi
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// Fault describes a failure to inject into the requests matching its Method and Path.
+//
+// The effects of a fault apply in the following order: the Latency delays the
+// response, ConnectionReset closes the connection, Code or Status replace the response,
+// and ActionError fails the actions started by the request.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Fault struct {
+	// Method is the HTTP method of the requests to match. Empty matches all methods.
+	Method string
+	// Path is the prefix of the paths of the requests to match, e.g. "/servers/1".
+	// Empty matches all paths.
+	Path string
+	// Rate is the fraction of the matching requests the fault is injected into, between
+	// 0 and 1. Zero injects the fault into all the matching requests.
+	Rate float64
+	// Times is the maximum number of injections of the fault. Zero means no limit.
+	Times int
+
+	// Latency delays the response.
+	Latency time.Duration
+	// ConnectionReset resets the connection instead of responding.
+	ConnectionReset bool
+	// Code replaces the response with an API error. Responses with
+	// [hcloud.ErrorCodeRateLimitExceeded] include the RateLimit-* headers.
+	Code hcloud.ErrorCode
+	// Status is the HTTP status code of the response. It defaults to the status code
+	// the API uses for the Code. Without Code, the response has no API error body, like
+	// the 502 and 504 responses of a proxy.
+	Status int
+	// ActionError fails the actions started by the request with the error code.
+	ActionError hcloud.ErrorCode
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	return (f.Method == "" || f.Method == r.Method) && strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+// errorCodeStatus are the HTTP status codes of the API errors.
+var errorCodeStatus = map[hcloud.ErrorCode]int{
+	hcloud.ErrorCodeConflict:            http.StatusConflict,
+	hcloud.ErrorCodeLocked:              http.StatusLocked,
+	hcloud.ErrorCodeProtected:           http.StatusLocked,
+	hcloud.ErrorCodeRateLimitExceeded:   http.StatusTooManyRequests,
+	hcloud.ErrorCodeNotFound:            http.StatusNotFound,
+	hcloud.ErrorCodeInvalidInput:        http.StatusBadRequest,
+	hcloud.ErrorCodeUniquenessError:     http.StatusConflict,
+	hcloud.ErrorCodeResourceUnavailable: http.StatusServiceUnavailable,
+	hcloud.ErrorCodeMaintenance:         http.StatusServiceUnavailable,
+	hcloud.ErrorCodeUnauthorized:        http.StatusUnauthorized,
+	hcloud.ErrorCodeForbidden:           http.StatusForbidden,
+	hcloud.ErrorCodeBadGateway:          http.StatusBadGateway,
+	hcloud.ErrorCodeTimeout:             http.StatusGatewayTimeout,
+}
+
+type actionErrorKey struct{}
+
+// trigger returns the faults injected into the request.
+func (s *Server) trigger(r *http.Request) []Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []Fault
+	for i := range s.opts.Faults {
+		f := &s.opts.Faults[i]
+		if !f.matches(r) {
+			continue
+		}
+		if f.Times > 0 && s.faultCounts[i] >= f.Times {
+			continue
+		}
+		if f.Rate > 0 && s.rand.Float64() >= f.Rate {
+			continue
+		}
+		s.faultCounts[i]++
+		result = append(result, *f)
+	}
+	return result
+}
+
+// injectFaults wraps the handler to inject the configured faults.
+func (s *Server) injectFaults(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		faults := s.trigger(r)
+
+		var latency time.Duration
+		for _, f := range faults {
+			latency += f.Latency
+		}
+		if latency > 0 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(latency):
+			}
+		}
+
+		for _, f := range faults {
+			switch {
+			case f.ConnectionReset:
+				resetConnection(w)
+				return
+			case f.Code != "":
+				status := f.Status
+				if status == 0 {
+					status = errorCodeStatus[f.Code]
+				}
+				if status == 0 {
+					status = http.StatusInternalServerError
+				}
+				if f.Code == hcloud.ErrorCodeRateLimitExceeded {
+					w.Header().Set("RateLimit-Limit", "3600")
+					w.Header().Set("RateLimit-Remaining", "0")
+					w.Header().Set("RateLimit-Reset", strconv.FormatInt(s.opts.Now().Add(time.Second).Unix(), 10))
+				}
+				writeJSON(w, status, schema.ErrorResponse{Error: schema.Error{
+					Code:    string(f.Code),
+					Message: "injected fault",
+				}})
+				return
+			case f.Status != 0:
+				w.Header().Set("Content-Type", "text/html")
+				w.WriteHeader(f.Status)
+				_, _ = w.Write([]byte("<html><body><h1>" + http.StatusText(f.Status) + "</h1></body></html>"))
+				return
+			}
+		}
+
+		for _, f := range faults {
+			if f.ActionError != "" {
+				r = r.WithContext(context.WithValue(r.Context(), actionErrorKey{}, f.ActionError))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// actionError returns the error code of the actions started by the request, if any.
+func actionError(r *http.Request) hcloud.ErrorCode {
+	code, _ := r.Context().Value(actionErrorKey{}).(hcloud.ErrorCode)
+	return code
+}
+
+// resetConnection closes the connection of the response without responding.
+func resetConnection(w http.ResponseWriter) {
+	conn, _, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		return
+	}
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		// Send a RST instead of a FIN.
+		_ = tcpConn.SetLinger(0)
+	}
+	_ = conn.Close()
+}
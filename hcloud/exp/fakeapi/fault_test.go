@@ -0,0 +1,160 @@
+package fakeapi
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestFaults(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rate limit", func(t *testing.T) {
+		fake := New(Opts{Faults: []Fault{
+			{Path: "/volumes", Times: 2, Code: hcloud.ErrorCodeRateLimitExceeded},
+		}})
+		t.Cleanup(fake.Close)
+
+		retries := 0
+		client := hcloud.NewClient(
+			hcloud.WithEndpoint(fake.URL),
+			hcloud.WithRetryOpts(hcloud.RetryOpts{
+				BackoffFunc: hcloud.ConstantBackoff(0),
+				MaxRetries:  3,
+				OnRetry: func(_ *http.Request, _ int, err error, _ time.Duration) {
+					assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeRateLimitExceeded))
+					retries++
+				},
+			}),
+		)
+
+		volumes, resp, err := client.Volume.List(ctx, hcloud.VolumeListOpts{})
+		require.NoError(t, err)
+		assert.Empty(t, volumes)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, 2, retries)
+
+		_, resp, err = client.Volume.List(ctx, hcloud.VolumeListOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+	})
+
+	t.Run("rate limit headers", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		_, client := newClient(t, Opts{
+			Now:    func() time.Time { return now },
+			Faults: []Fault{{Code: hcloud.ErrorCodeRateLimitExceeded}},
+		})
+
+		_, resp, err := client.Volume.List(ctx, hcloud.VolumeListOpts{})
+		require.True(t, hcloud.IsError(err, hcloud.ErrorCodeRateLimitExceeded), err)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, 3600, resp.Meta.Ratelimit.Limit)
+		assert.Equal(t, 0, resp.Meta.Ratelimit.Remaining)
+		assert.True(t, now.Add(time.Second).Equal(resp.Meta.Ratelimit.Reset))
+	})
+
+	t.Run("bad gateway", func(t *testing.T) {
+		_, client := newClient(t, Opts{Faults: []Fault{
+			{Method: "GET", Times: 1, Status: http.StatusBadGateway},
+			{Method: "GET", Times: 1, Status: http.StatusGatewayTimeout},
+		}})
+
+		_, resp, err := client.Volume.List(ctx, hcloud.VolumeListOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		fake := New(Opts{
+			Rand:   rand.New(rand.NewPCG(1, 2)),
+			Faults: []Fault{{Rate: 0.5, Status: http.StatusGatewayTimeout}},
+		})
+		t.Cleanup(fake.Close)
+
+		client := hcloud.NewClient(
+			hcloud.WithEndpoint(fake.URL),
+			hcloud.WithRetryOpts(hcloud.RetryOpts{MaxRetries: 0}),
+		)
+
+		failed := 0
+		for range 100 {
+			if _, _, err := client.Volume.List(ctx, hcloud.VolumeListOpts{}); err != nil {
+				require.ErrorIs(t, err, hcloud.ErrStatusCode)
+				failed++
+			}
+		}
+		assert.InDelta(t, 50, failed, 15)
+	})
+
+	t.Run("connection reset", func(t *testing.T) {
+		_, client := newClient(t, Opts{Faults: []Fault{
+			{Method: "GET", Path: "/volumes", Times: 1, ConnectionReset: true},
+		}})
+
+		_, _, err := client.Volume.List(ctx, hcloud.VolumeListOpts{})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, hcloud.ErrStatusCode)
+
+		_, _, err = client.Volume.List(ctx, hcloud.VolumeListOpts{})
+		require.NoError(t, err)
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		_, client := newClient(t, Opts{Faults: []Fault{{Latency: time.Second}}})
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, _, err := client.Volume.List(ctx, hcloud.VolumeListOpts{})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		_, client := newClient(t, Opts{Faults: []Fault{
+			{Method: "DELETE", Path: "/volumes/1", Code: hcloud.ErrorCodeLocked},
+		}})
+
+		result, _, err := client.Volume.Create(ctx, hcloud.VolumeCreateOpts{
+			Name:     "data",
+			Size:     10,
+			Location: &hcloud.Location{Name: "fsn1"},
+		})
+		require.NoError(t, err)
+
+		resp, err := client.Volume.Delete(ctx, result.Volume)
+		require.True(t, hcloud.IsError(err, hcloud.ErrorCodeLocked), err)
+		assert.Equal(t, http.StatusLocked, resp.StatusCode)
+	})
+
+	t.Run("action error", func(t *testing.T) {
+		_, client := newClient(t, Opts{Faults: []Fault{
+			{Method: "POST", Path: "/servers", ActionError: hcloud.ErrorCodePlacementError},
+		}})
+
+		result, _, err := client.Server.Create(ctx, hcloud.ServerCreateOpts{
+			Name:       "web",
+			ServerType: &hcloud.ServerType{Name: "cpx22"},
+			Image:      &hcloud.Image{Name: "debian-13"},
+		})
+		require.NoError(t, err)
+
+		err = client.Action.WaitFor(ctx, result.Action)
+		require.Error(t, err)
+
+		actionErr := hcloud.ActionError{}
+		require.ErrorAs(t, err, &actionErr)
+		assert.Equal(t, string(hcloud.ErrorCodePlacementError), actionErr.Code)
+
+		server, _, err := client.Server.GetByID(ctx, result.Server.ID)
+		require.NoError(t, err)
+		assert.Equal(t, hcloud.ServerStatusInitializing, server.Status)
+	})
+}
@@ -0,0 +1,144 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func (s *Server) registerFirewalls() {
+	s.firewalls = &kind[schema.Firewall]{
+		server:        s,
+		path:          "firewalls",
+		key:           "firewall",
+		resource:      hcloud.ActionResourceTypeFirewall,
+		pluralActions: true,
+		id:            func(o *schema.Firewall) int64 { return o.ID },
+		name:          func(o *schema.Firewall) string { return o.Name },
+		labels:        func(o *schema.Firewall) map[string]string { return o.Labels },
+		create:        s.createFirewall,
+		onDelete: func(o *schema.Firewall) *action {
+			s.removeFirewall(o, o.AppliedTo)
+			return nil
+		},
+		commands: map[string]command[schema.Firewall]{
+			"set_rules": func(o *schema.Firewall, r *http.Request) (func(), error) {
+				body := schema.FirewallActionSetRulesRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.Rules = firewallRules(body.Rules)
+				return nil, nil
+			},
+			"apply_to_resources": func(o *schema.Firewall, r *http.Request) (func(), error) {
+				body := schema.FirewallActionApplyToResourcesRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				return nil, s.applyFirewall(o, body.ApplyTo)
+			},
+			"remove_from_resources": func(o *schema.Firewall, r *http.Request) (func(), error) {
+				body := schema.FirewallActionRemoveFromResourcesRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				s.removeFirewall(o, body.RemoveFrom)
+				return nil, nil
+			},
+		},
+	}
+	s.firewalls.register()
+}
+
+func (s *Server) createFirewall(r *http.Request) (response, error) {
+	body := schema.FirewallCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if err := s.firewalls.checkName(body.Name, 0); err != nil {
+		return response{}, err
+	}
+
+	firewall := &schema.Firewall{
+		ID:        s.firewalls.newID(),
+		Name:      body.Name,
+		Labels:    labelsOrEmpty(body.Labels),
+		Rules:     firewallRules(body.Rules),
+		AppliedTo: []schema.FirewallResource{},
+		Created:   s.now(),
+	}
+	if err := s.applyFirewall(firewall, body.ApplyTo); err != nil {
+		return response{}, err
+	}
+	s.firewalls.insert(firewall)
+
+	resp := schema.FirewallCreateResponse{Firewall: *firewall, Actions: []schema.Action{}}
+	if len(body.ApplyTo) > 0 {
+		a := s.firewalls.startAction("apply_firewall", firewall, nil)
+		resp.Actions = append(resp.Actions, a.Action)
+	}
+	return response{http.StatusCreated, resp}, nil
+}
+
+func firewallRules(rules []schema.FirewallRuleRequest) []schema.FirewallRule {
+	result := make([]schema.FirewallRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, schema.FirewallRule{
+			Direction:      rule.Direction,
+			SourceIPs:      append([]string{}, rule.SourceIPs...),
+			DestinationIPs: append([]string{}, rule.DestinationIPs...),
+			Protocol:       rule.Protocol,
+			Port:           rule.Port,
+			Description:    rule.Description,
+		})
+	}
+	return result
+}
+
+func (s *Server) applyFirewall(firewall *schema.Firewall, resources []schema.FirewallResource) error {
+	for _, resource := range resources {
+		if resource.Type == "server" {
+			if resource.Server == nil {
+				return errInvalidInput("server is required")
+			}
+			if _, err := s.servers.get(resource.Server.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, resource := range resources {
+		firewall.AppliedTo = append(firewall.AppliedTo, resource)
+		if resource.Type == "server" {
+			server := s.servers.items[resource.Server.ID]
+			server.PublicNet.Firewalls = append(server.PublicNet.Firewalls, schema.ServerFirewall{ID: firewall.ID, Status: "applied"})
+		}
+	}
+	return nil
+}
+
+func (s *Server) removeFirewall(firewall *schema.Firewall, resources []schema.FirewallResource) {
+	for _, resource := range slices.Clone(resources) {
+		firewall.AppliedTo = slices.DeleteFunc(firewall.AppliedTo, func(r schema.FirewallResource) bool {
+			switch {
+			case r.Type != resource.Type:
+				return false
+			case r.Server != nil && resource.Server != nil:
+				return r.Server.ID == resource.Server.ID
+			case r.LabelSelector != nil && resource.LabelSelector != nil:
+				return r.LabelSelector.Selector == resource.LabelSelector.Selector
+			}
+			return false
+		})
+
+		if resource.Type == "server" && resource.Server != nil {
+			if server, ok := s.servers.items[resource.Server.ID]; ok {
+				server.PublicNet.Firewalls = slices.DeleteFunc(server.PublicNet.Firewalls, func(f schema.ServerFirewall) bool {
+					return f.ID == firewall.ID
+				})
+			}
+		}
+	}
+}
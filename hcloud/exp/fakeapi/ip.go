@@ -0,0 +1,234 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// fakeIP returns an IP address of the documentation ranges.
+func fakeIP(ipType string, n int64) string {
+	if ipType == "ipv6" {
+		return fmt.Sprintf("2001:db8:%x::/64", n)
+	}
+	return fmt.Sprintf("203.0.113.%d", n%256)
+}
+
+func (s *Server) registerPrimaryIPs() {
+	s.primaryIPs = &kind[schema.PrimaryIP]{
+		server:   s,
+		path:     "primary_ips",
+		key:      "primary_ip",
+		resource: hcloud.ActionResourceTypePrimaryIP,
+		id:       func(o *schema.PrimaryIP) int64 { return o.ID },
+		name:     func(o *schema.PrimaryIP) string { return o.Name },
+		labels:   func(o *schema.PrimaryIP) map[string]string { return o.Labels },
+		create:   s.createPrimaryIP,
+		commands: map[string]command[schema.PrimaryIP]{
+			"assign": func(o *schema.PrimaryIP, r *http.Request) (func(), error) {
+				body := schema.PrimaryIPActionAssignRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				if o.AssigneeID != nil {
+					return nil, newError(http.StatusConflict, hcloud.ErrorCodePrimaryIPAssigned, "primary ip is already assigned")
+				}
+				if _, err := s.servers.get(body.AssigneeID); err != nil {
+					return nil, err
+				}
+				o.AssigneeID = &body.AssigneeID
+				o.AssigneeType = body.AssigneeType
+				return nil, nil
+			},
+			"unassign": func(o *schema.PrimaryIP, _ *http.Request) (func(), error) {
+				if o.AssigneeID != nil {
+					if server, ok := s.servers.items[*o.AssigneeID]; ok {
+						switch o.ID {
+						case server.PublicNet.IPv4.ID:
+							server.PublicNet.IPv4 = schema.ServerPublicNetIPv4{}
+						case server.PublicNet.IPv6.ID:
+							server.PublicNet.IPv6 = schema.ServerPublicNetIPv6{}
+						}
+					}
+				}
+				o.AssigneeID = nil
+				return nil, nil
+			},
+		},
+	}
+	s.primaryIPs.register()
+}
+
+// newPrimaryIP creates and stores a new primary IP.
+func (s *Server) newPrimaryIP(name, ipType string, location schema.Location) *schema.PrimaryIP {
+	id := s.primaryIPs.newID()
+	ip := &schema.PrimaryIP{
+		ID:           id,
+		Name:         name,
+		Type:         ipType,
+		IP:           fakeIP(ipType, id),
+		Labels:       map[string]string{},
+		DNSPtr:       []schema.PrimaryIPDNSPTR{},
+		AssigneeType: "server",
+		Created:      s.now(),
+		Location:     location,
+	}
+	s.primaryIPs.insert(ip)
+	return ip
+}
+
+func (s *Server) createPrimaryIP(r *http.Request) (response, error) {
+	body := schema.PrimaryIPCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if err := s.primaryIPs.checkName(body.Name, 0); err != nil {
+		return response{}, err
+	}
+	if body.Type != "ipv4" && body.Type != "ipv6" {
+		return response{}, errInvalidInput("invalid type: %s", body.Type)
+	}
+	if body.AssigneeID != nil {
+		if _, err := s.servers.get(*body.AssigneeID); err != nil {
+			return response{}, err
+		}
+	}
+
+	location := body.Location
+	if location == "" {
+		location = "fsn1"
+	}
+
+	ip := s.newPrimaryIP(body.Name, body.Type, schema.Location{Name: location})
+	ip.Labels = labelsOrEmpty(body.Labels)
+	ip.AssigneeID = body.AssigneeID
+	if body.AutoDelete != nil {
+		ip.AutoDelete = *body.AutoDelete
+	}
+
+	resp := schema.PrimaryIPCreateResponse{PrimaryIP: *ip}
+	if ip.AssigneeID != nil {
+		a := s.primaryIPs.startAction("create_primary_ip", ip, nil)
+		resp.Action = &a.Action
+	}
+	return response{http.StatusCreated, resp}, nil
+}
+
+func (s *Server) registerFloatingIPs() {
+	s.floatingIPs = &kind[schema.FloatingIP]{
+		server:   s,
+		path:     "floating_ips",
+		key:      "floating_ip",
+		resource: hcloud.ActionResourceTypeFloatingIP,
+		id:       func(o *schema.FloatingIP) int64 { return o.ID },
+		name:     func(o *schema.FloatingIP) string { return o.Name },
+		labels:   func(o *schema.FloatingIP) map[string]string { return o.Labels },
+		create:   s.createFloatingIP,
+		onDelete: func(o *schema.FloatingIP) *action {
+			s.unassignFloatingIP(o)
+			return nil
+		},
+		commands: map[string]command[schema.FloatingIP]{
+			"assign": func(o *schema.FloatingIP, r *http.Request) (func(), error) {
+				body := schema.FloatingIPActionAssignRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				server, err := s.servers.get(body.Server)
+				if err != nil {
+					return nil, err
+				}
+				s.unassignFloatingIP(o)
+				o.Server = &server.ID
+				server.PublicNet.FloatingIPs = append(server.PublicNet.FloatingIPs, o.ID)
+				return nil, nil
+			},
+			"unassign": func(o *schema.FloatingIP, _ *http.Request) (func(), error) {
+				s.unassignFloatingIP(o)
+				return nil, nil
+			},
+		},
+	}
+	s.floatingIPs.register()
+}
+
+func (s *Server) createFloatingIP(r *http.Request) (response, error) {
+	body := schema.FloatingIPCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if body.Type != "ipv4" && body.Type != "ipv6" {
+		return response{}, errInvalidInput("invalid type: %s", body.Type)
+	}
+
+	id := s.floatingIPs.newID()
+	name := fmt.Sprintf("floating_ip-%d", id)
+	if body.Name != nil {
+		name = *body.Name
+	}
+	if err := s.floatingIPs.checkName(name, 0); err != nil {
+		return response{}, err
+	}
+
+	var server *schema.Server
+	if body.Server != nil {
+		var err error
+		if server, err = s.servers.get(*body.Server); err != nil {
+			return response{}, err
+		}
+	}
+
+	location := "fsn1"
+	switch {
+	case body.HomeLocation != nil:
+		location = *body.HomeLocation
+	case server != nil:
+		location = server.Location.Name
+	}
+
+	ip := &schema.FloatingIP{
+		ID:           id,
+		Name:         name,
+		Description:  body.Description,
+		Type:         body.Type,
+		IP:           fakeIP(body.Type, id+128),
+		DNSPtr:       []schema.FloatingIPDNSPtr{},
+		HomeLocation: schema.Location{Name: location},
+		Labels:       labelsOrEmpty(body.Labels),
+		Created:      s.now(),
+	}
+	s.floatingIPs.insert(ip)
+
+	resp := schema.FloatingIPCreateResponse{FloatingIP: *ip}
+	if server != nil {
+		ip.Server = &server.ID
+		server.PublicNet.FloatingIPs = append(server.PublicNet.FloatingIPs, ip.ID)
+		a := s.floatingIPs.startAction("assign_floating_ip", ip, nil)
+		resp.FloatingIP = *ip
+		resp.Action = &a.Action
+	}
+	return response{http.StatusCreated, resp}, nil
+}
+
+func (s *Server) unassignFloatingIP(ip *schema.FloatingIP) {
+	if ip.Server == nil {
+		return
+	}
+	if server, ok := s.servers.items[*ip.Server]; ok {
+		server.PublicNet.FloatingIPs = deleteID(server.PublicNet.FloatingIPs, ip.ID)
+	}
+	ip.Server = nil
+}
+
+// deleteID removes the ID from the list.
+func deleteID(ids []int64, id int64) []int64 {
+	result := make([]int64, 0, len(ids))
+	for _, v := range ids {
+		if v != id {
+			result = append(result, v)
+		}
+	}
+	return result
+}
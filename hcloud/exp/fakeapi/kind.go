@@ -0,0 +1,361 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/labelutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// command applies an action on a resource, and returns the function to call when the
+// action completes.
+type command[T any] func(obj *T, r *http.Request) (done func(), err error)
+
+// kind holds the resources of a kind, and implements their generic API endpoints.
+type kind[T any] struct {
+	server *Server
+
+	// path is the path of the resources, also used as key in the list responses,
+	// e.g. "servers".
+	path string
+	// key is the key of a single resource in the responses, e.g. "server".
+	key string
+	// resource is the type of the resource in the action resources, e.g. "server".
+	resource hcloud.ActionResourceType
+	// byName allows to reference the resources by name in the paths.
+	byName bool
+	// pluralActions returns the actions of the commands as a list.
+	pluralActions bool
+
+	id     func(*T) int64
+	name   func(*T) string
+	labels func(*T) map[string]string
+
+	// create handles the create requests.
+	create handlerFunc
+	// onDelete is called after a resource was deleted. The returned action, if any, is
+	// returned in the response.
+	onDelete func(obj *T) *action
+	// commands are the supported actions, in addition to change_protection.
+	commands map[string]command[T]
+
+	items  map[int64]*T
+	nextID int64
+}
+
+func (k *kind[T]) newID() int64 {
+	k.nextID++
+	return k.nextID
+}
+
+func (k *kind[T]) insert(obj *T) {
+	if k.items == nil {
+		k.items = make(map[int64]*T)
+	}
+	k.items[k.id(obj)] = obj
+}
+
+// sorted returns the resources ordered by ID.
+func (k *kind[T]) sorted() []*T {
+	ids := slices.Sorted(maps.Keys(k.items))
+	result := make([]*T, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, k.items[id])
+	}
+	return result
+}
+
+// lookup returns the resource referenced by its ID, or by its name when supported.
+func (k *kind[T]) lookup(idOrName string) (*T, error) {
+	if id, err := strconv.ParseInt(idOrName, 10, 64); err == nil {
+		if obj, ok := k.items[id]; ok {
+			return obj, nil
+		}
+	}
+	if k.byName {
+		for _, obj := range k.items {
+			if k.name(obj) == idOrName {
+				return obj, nil
+			}
+		}
+	}
+	return nil, errNotFound(k.key)
+}
+
+// get returns the resource with the ID, or an error if it does not exist.
+func (k *kind[T]) get(id int64) (*T, error) {
+	obj, ok := k.items[id]
+	if !ok {
+		return nil, errNotFound(k.key)
+	}
+	return obj, nil
+}
+
+// checkName returns an error if another resource already uses the name.
+func (k *kind[T]) checkName(name string, except int64) error {
+	if name == "" {
+		return errInvalidInput("name is required")
+	}
+	for id, obj := range k.items {
+		if id != except && k.name(obj) == name {
+			return newError(http.StatusConflict, hcloud.ErrorCodeUniquenessError, "name is already used")
+		}
+	}
+	return nil
+}
+
+func (k *kind[T]) ref(obj *T) schema.ActionResourceReference {
+	return schema.ActionResourceReference{ID: k.id(obj), Type: string(k.resource)}
+}
+
+// startAction starts an action on the resource.
+func (k *kind[T]) startAction(command string, obj *T, done func()) *action {
+	return k.server.actions.start(command, done, k.ref(obj))
+}
+
+func (k *kind[T]) hasResource(a *action, id int64) bool {
+	return slices.ContainsFunc(a.Resources, func(ref schema.ActionResourceReference) bool {
+		return ref.Type == string(k.resource) && (id == 0 || ref.ID == id)
+	})
+}
+
+func (k *kind[T]) register() {
+	s := k.server
+
+	s.handle("GET /"+k.path, k.handleList)
+	if k.create != nil {
+		s.handle("POST /"+k.path, k.create)
+	}
+	s.handle("GET /"+k.path+"/{id}", func(r *http.Request) (response, error) {
+		if r.PathValue("id") == "actions" {
+			return s.actions.list(r, func(a *action) bool { return k.hasResource(a, 0) })
+		}
+		obj, err := k.lookup(r.PathValue("id"))
+		if err != nil {
+			return response{}, err
+		}
+		return response{http.StatusOK, map[string]any{k.key: obj}}, nil
+	})
+	s.handle("PUT /"+k.path+"/{id}", k.handleUpdate)
+	s.handle("DELETE /"+k.path+"/{id}", k.handleDelete)
+	s.handle("GET /"+k.path+"/{a}/{b}", func(r *http.Request) (response, error) {
+		switch {
+		case r.PathValue("a") == "actions":
+			id, err := pathID(r, "b")
+			if err != nil {
+				return response{}, err
+			}
+			if a := s.actions.get(id); a != nil && k.hasResource(a, 0) {
+				return response{http.StatusOK, schema.ActionGetResponse{Action: a.Action}}, nil
+			}
+			return response{}, errNotFound("action")
+		case r.PathValue("b") == "actions":
+			obj, err := k.lookup(r.PathValue("a"))
+			if err != nil {
+				return response{}, err
+			}
+			return s.actions.list(r, func(a *action) bool { return k.hasResource(a, k.id(obj)) })
+		}
+		return response{}, errNotFound("resource")
+	})
+	s.handle("POST /"+k.path+"/{id}/actions/{command}", k.handleCommand)
+}
+
+func (k *kind[T]) handleList(r *http.Request) (response, error) {
+	query := r.URL.Query()
+
+	matcher, err := labelutil.ParseSelector(query.Get("label_selector"))
+	if err != nil {
+		return response{}, errInvalidInput("%s", err)
+	}
+
+	result := make([]*T, 0)
+	for _, obj := range k.sorted() {
+		if name := query.Get("name"); name != "" && k.name(obj) != name {
+			continue
+		}
+		if !matcher.Matches(k.labels(obj)) {
+			continue
+		}
+		result = append(result, obj)
+	}
+
+	page, meta, err := paginate(r, result)
+	if err != nil {
+		return response{}, err
+	}
+	return response{http.StatusOK, map[string]any{k.path: page, "meta": meta}}, nil
+}
+
+func (k *kind[T]) handleUpdate(r *http.Request) (response, error) {
+	obj, err := k.lookup(r.PathValue("id"))
+	if err != nil {
+		return response{}, err
+	}
+
+	body := map[string]json.RawMessage{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+
+	updated, err := merge(obj, body)
+	if err != nil {
+		return response{}, err
+	}
+	if k.name(updated) != k.name(obj) {
+		if err := k.checkName(k.name(updated), k.id(obj)); err != nil {
+			return response{}, err
+		}
+	}
+
+	*obj = *updated
+	return response{http.StatusOK, map[string]any{k.key: obj}}, nil
+}
+
+func (k *kind[T]) handleDelete(r *http.Request) (response, error) {
+	obj, err := k.lookup(r.PathValue("id"))
+	if err != nil {
+		return response{}, err
+	}
+	if protected(obj) {
+		return response{}, newError(http.StatusLocked, hcloud.ErrorCodeProtected, "%s is delete protected", k.key)
+	}
+
+	delete(k.items, k.id(obj))
+
+	if k.onDelete != nil {
+		if a := k.onDelete(obj); a != nil {
+			return response{http.StatusOK, schema.ActionGetResponse{Action: a.Action}}, nil
+		}
+	}
+	return response{status: http.StatusNoContent}, nil
+}
+
+func (k *kind[T]) handleCommand(r *http.Request) (response, error) {
+	obj, err := k.lookup(r.PathValue("id"))
+	if err != nil {
+		return response{}, err
+	}
+
+	name := r.PathValue("command")
+
+	var done func()
+	switch cmd, ok := k.commands[name]; {
+	case ok:
+		done, err = cmd(obj, r)
+	case name == "change_protection":
+		err = changeProtection(obj, r)
+	}
+	if err != nil {
+		return response{}, err
+	}
+
+	a := k.startAction(name, obj, done)
+	if k.pluralActions {
+		return response{http.StatusCreated, schema.ActionListResponse{Actions: []schema.Action{a.Action}}}, nil
+	}
+	return actionResponse(a), nil
+}
+
+// merge returns a copy of the resource, with the fields of the body that exist in the
+// resource replaced.
+func merge[T any](obj *T, body map[string]json.RawMessage) (*T, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	for key, value := range body {
+		if _, ok := fields[key]; ok {
+			fields[key] = value
+		}
+	}
+
+	data, err = json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	result := new(T)
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, errInvalidInput("%s", err)
+	}
+	return result, nil
+}
+
+// changeProtection updates the protection field of the resource with the request body.
+func changeProtection[T any](obj *T, r *http.Request) error {
+	body := map[string]json.RawMessage{}
+	if err := decodeBody(r, &body); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var fields struct {
+		Protection map[string]json.RawMessage `json:"protection"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil || fields.Protection == nil {
+		return newError(http.StatusBadRequest, hcloud.ErrorUnsupportedError, "resource does not support protection")
+	}
+
+	for key, value := range body {
+		if _, ok := fields.Protection[key]; ok {
+			fields.Protection[key] = value
+		}
+	}
+
+	updated, err := merge(obj, map[string]json.RawMessage{"protection": mustMarshal(fields.Protection)})
+	if err != nil {
+		return err
+	}
+	*obj = *updated
+	return nil
+}
+
+// protected returns whether the resource is delete protected.
+func protected[T any](obj *T) bool {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return false
+	}
+	var fields struct {
+		Protection struct {
+			Delete bool `json:"delete"`
+		} `json:"protection"`
+	}
+	_ = json.Unmarshal(data, &fields)
+	return fields.Protection.Delete
+}
+
+// convert converts the value to another type with the same JSON representation, e.g. a
+// request body to a resource.
+func convert[T any](v any) (T, error) {
+	var result T
+	data, err := json.Marshal(v)
+	if err != nil {
+		return result, err
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, errInvalidInput("%s", err)
+	}
+	return result, nil
+}
+
+func mustMarshal(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,312 @@
+package fakeapi
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func (s *Server) registerLoadBalancers() {
+	s.loadBalancers = &kind[schema.LoadBalancer]{
+		server:   s,
+		path:     "load_balancers",
+		key:      "load_balancer",
+		resource: hcloud.ActionResourceTypeLoadBalancer,
+		id:       func(o *schema.LoadBalancer) int64 { return o.ID },
+		name:     func(o *schema.LoadBalancer) string { return o.Name },
+		labels:   func(o *schema.LoadBalancer) map[string]string { return o.Labels },
+		create:   s.createLoadBalancer,
+		onDelete: func(o *schema.LoadBalancer) *action {
+			for _, network := range s.networks.items {
+				network.LoadBalancers = deleteID(network.LoadBalancers, o.ID)
+			}
+			return nil
+		},
+		commands: map[string]command[schema.LoadBalancer]{
+			"add_target": func(o *schema.LoadBalancer, r *http.Request) (func(), error) {
+				body := schema.LoadBalancerActionAddTargetRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				target, err := convert[schema.LoadBalancerTarget](body)
+				if err != nil {
+					return nil, err
+				}
+				return nil, s.addLoadBalancerTargets(o, target)
+			},
+			"remove_target": func(o *schema.LoadBalancer, r *http.Request) (func(), error) {
+				body := schema.LoadBalancerActionRemoveTargetRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				target, err := convert[schema.LoadBalancerTarget](body)
+				if err != nil {
+					return nil, err
+				}
+				i := slices.IndexFunc(o.Targets, func(t schema.LoadBalancerTarget) bool { return sameTarget(t, target) })
+				if i < 0 {
+					return nil, errNotFound("target")
+				}
+				o.Targets = slices.Delete(o.Targets, i, i+1)
+				return nil, nil
+			},
+			"add_service": func(o *schema.LoadBalancer, r *http.Request) (func(), error) {
+				body := schema.LoadBalancerActionAddServiceRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				service, err := convert[schema.LoadBalancerService](body)
+				if err != nil {
+					return nil, err
+				}
+				return nil, addLoadBalancerServices(o, service)
+			},
+			"update_service": func(o *schema.LoadBalancer, r *http.Request) (func(), error) {
+				body := schema.LoadBalancerActionUpdateServiceRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				i := slices.IndexFunc(o.Services, func(svc schema.LoadBalancerService) bool { return svc.ListenPort == body.ListenPort })
+				if i < 0 {
+					return nil, errNotFound("service")
+				}
+				fields, err := convert[map[string]json.RawMessage](body)
+				if err != nil {
+					return nil, err
+				}
+				service, err := merge(&o.Services[i], fields)
+				if err != nil {
+					return nil, err
+				}
+				o.Services[i] = *service
+				return nil, nil
+			},
+			"delete_service": func(o *schema.LoadBalancer, r *http.Request) (func(), error) {
+				body := schema.LoadBalancerDeleteServiceRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				i := slices.IndexFunc(o.Services, func(svc schema.LoadBalancerService) bool { return svc.ListenPort == body.ListenPort })
+				if i < 0 {
+					return nil, errNotFound("service")
+				}
+				o.Services = slices.Delete(o.Services, i, i+1)
+				return nil, nil
+			},
+			"change_algorithm": func(o *schema.LoadBalancer, r *http.Request) (func(), error) {
+				body := schema.LoadBalancerActionChangeAlgorithmRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.Algorithm.Type = body.Type
+				return nil, nil
+			},
+			"change_type": func(o *schema.LoadBalancer, r *http.Request) (func(), error) {
+				body := schema.LoadBalancerActionChangeTypeRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.LoadBalancerType = schema.LoadBalancerType{ID: body.LoadBalancerType.ID, Name: body.LoadBalancerType.Name}
+				return nil, nil
+			},
+			"attach_to_network": func(o *schema.LoadBalancer, r *http.Request) (func(), error) {
+				body := schema.LoadBalancerActionAttachToNetworkRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				return nil, s.attachLoadBalancerToNetwork(o, body.Network)
+			},
+			"detach_from_network": func(o *schema.LoadBalancer, r *http.Request) (func(), error) {
+				body := schema.LoadBalancerActionDetachFromNetworkRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.PrivateNet = slices.DeleteFunc(o.PrivateNet, func(n schema.LoadBalancerPrivateNet) bool { return n.Network == body.Network })
+				if network, ok := s.networks.items[body.Network]; ok {
+					network.LoadBalancers = deleteID(network.LoadBalancers, o.ID)
+				}
+				return nil, nil
+			},
+			"enable_public_interface": func(o *schema.LoadBalancer, _ *http.Request) (func(), error) {
+				o.PublicNet.Enabled = true
+				return nil, nil
+			},
+			"disable_public_interface": func(o *schema.LoadBalancer, _ *http.Request) (func(), error) {
+				o.PublicNet.Enabled = false
+				return nil, nil
+			},
+		},
+	}
+	s.loadBalancers.register()
+}
+
+func (s *Server) createLoadBalancer(r *http.Request) (response, error) {
+	body := schema.LoadBalancerCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if err := s.loadBalancers.checkName(body.Name, 0); err != nil {
+		return response{}, err
+	}
+	if body.LoadBalancerType.ID == 0 && body.LoadBalancerType.Name == "" {
+		return response{}, errInvalidInput("load_balancer_type is required")
+	}
+	if body.Network != nil {
+		if _, err := s.networks.get(*body.Network); err != nil {
+			return response{}, err
+		}
+	}
+
+	location := "fsn1"
+	if body.Location != nil {
+		location = *body.Location
+	}
+	algorithm := "round_robin"
+	if body.Algorithm != nil {
+		algorithm = body.Algorithm.Type
+	}
+
+	id := s.loadBalancers.newID()
+	lb := &schema.LoadBalancer{
+		ID:   id,
+		Name: body.Name,
+		PublicNet: schema.LoadBalancerPublicNet{
+			Enabled: body.PublicInterface == nil || *body.PublicInterface,
+			IPv4:    schema.LoadBalancerPublicNetIPv4{IP: fakeIP("ipv4", id+64)},
+			IPv6:    schema.LoadBalancerPublicNetIPv6{IP: fakeIP("ipv6", id+64)},
+		},
+		PrivateNet:       []schema.LoadBalancerPrivateNet{},
+		Location:         schema.Location{Name: location},
+		LoadBalancerType: schema.LoadBalancerType{ID: body.LoadBalancerType.ID, Name: body.LoadBalancerType.Name},
+		Labels:           labelsOrEmpty(body.Labels),
+		Created:          s.now(),
+		Services:         []schema.LoadBalancerService{},
+		Targets:          []schema.LoadBalancerTarget{},
+		Algorithm:        schema.LoadBalancerAlgorithm{Type: algorithm},
+	}
+
+	targets, err := convert[[]schema.LoadBalancerTarget](body.Targets)
+	if err != nil {
+		return response{}, err
+	}
+	services, err := convert[[]schema.LoadBalancerService](body.Services)
+	if err != nil {
+		return response{}, err
+	}
+	if err := s.addLoadBalancerTargets(lb, targets...); err != nil {
+		return response{}, err
+	}
+	if err := addLoadBalancerServices(lb, services...); err != nil {
+		return response{}, err
+	}
+	if body.Network != nil {
+		if err := s.attachLoadBalancerToNetwork(lb, *body.Network); err != nil {
+			return response{}, err
+		}
+	}
+
+	s.loadBalancers.insert(lb)
+
+	a := s.loadBalancers.startAction("create_load_balancer", lb, nil)
+	return response{http.StatusCreated, schema.LoadBalancerCreateResponse{LoadBalancer: *lb, Action: a.Action}}, nil
+}
+
+func (s *Server) addLoadBalancerTargets(lb *schema.LoadBalancer, targets ...schema.LoadBalancerTarget) error {
+	for _, target := range targets {
+		switch target.Type {
+		case "server":
+			if target.Server == nil {
+				return errInvalidInput("server is required")
+			}
+			if _, err := s.servers.get(target.Server.ID); err != nil {
+				return err
+			}
+		case "label_selector":
+			if target.LabelSelector == nil {
+				return errInvalidInput("label_selector is required")
+			}
+		case "ip":
+			if target.IP == nil {
+				return errInvalidInput("ip is required")
+			}
+		default:
+			return errInvalidInput("invalid target type: %s", target.Type)
+		}
+		if slices.ContainsFunc(lb.Targets, func(t schema.LoadBalancerTarget) bool { return sameTarget(t, target) }) {
+			return newError(http.StatusConflict, hcloud.ErrorCodeTargetAlreadyDefined, "target is already defined")
+		}
+
+		target.HealthStatus = []schema.LoadBalancerTargetHealthStatus{}
+		for _, service := range lb.Services {
+			target.HealthStatus = append(target.HealthStatus, schema.LoadBalancerTargetHealthStatus{
+				ListenPort: service.ListenPort,
+				Status:     "healthy",
+			})
+		}
+		lb.Targets = append(lb.Targets, target)
+	}
+	return nil
+}
+
+func addLoadBalancerServices(lb *schema.LoadBalancer, services ...schema.LoadBalancerService) error {
+	for _, service := range services {
+		switch service.Protocol {
+		case "http":
+			service.ListenPort = cmp.Or(service.ListenPort, 80)
+			service.DestinationPort = cmp.Or(service.DestinationPort, 80)
+		case "https":
+			service.ListenPort = cmp.Or(service.ListenPort, 443)
+			service.DestinationPort = cmp.Or(service.DestinationPort, 80)
+		case "tcp":
+			if service.ListenPort == 0 || service.DestinationPort == 0 {
+				return errInvalidInput("listen_port and destination_port are required")
+			}
+		default:
+			return errInvalidInput("invalid protocol: %s", service.Protocol)
+		}
+		if slices.ContainsFunc(lb.Services, func(svc schema.LoadBalancerService) bool { return svc.ListenPort == service.ListenPort }) {
+			return newError(http.StatusConflict, hcloud.ErrorCodeSourcePortAlreadyUsed, "listen port %d is already used", service.ListenPort)
+		}
+		lb.Services = append(lb.Services, service)
+	}
+	return nil
+}
+
+func (s *Server) attachLoadBalancerToNetwork(lb *schema.LoadBalancer, id int64) error {
+	network, err := s.networks.get(id)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(lb.PrivateNet, func(n schema.LoadBalancerPrivateNet) bool { return n.Network == id }) {
+		return newError(http.StatusConflict, hcloud.ErrorCodeLoadBalancerAlreadyAttached, "load balancer is already attached to network %d", id)
+	}
+
+	network.LoadBalancers = append(network.LoadBalancers, lb.ID)
+	lb.PrivateNet = append(lb.PrivateNet, schema.LoadBalancerPrivateNet{
+		Network: id,
+		IP:      fmt.Sprintf("10.0.1.%d", len(network.LoadBalancers)+1),
+	})
+	return nil
+}
+
+// sameTarget returns whether both targets reference the same server, label selector or
+// IP.
+func sameTarget(a, b schema.LoadBalancerTarget) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	switch {
+	case a.Server != nil && b.Server != nil:
+		return a.Server.ID == b.Server.ID
+	case a.LabelSelector != nil && b.LabelSelector != nil:
+		return a.LabelSelector.Selector == b.LabelSelector.Selector
+	case a.IP != nil && b.IP != nil:
+		return a.IP.IP == b.IP.IP
+	}
+	return false
+}
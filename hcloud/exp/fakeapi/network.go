@@ -0,0 +1,116 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func (s *Server) registerNetworks() {
+	s.networks = &kind[schema.Network]{
+		server:   s,
+		path:     "networks",
+		key:      "network",
+		resource: hcloud.ActionResourceTypeNetwork,
+		id:       func(o *schema.Network) int64 { return o.ID },
+		name:     func(o *schema.Network) string { return o.Name },
+		labels:   func(o *schema.Network) map[string]string { return o.Labels },
+		create:   s.createNetwork,
+		onDelete: func(o *schema.Network) *action {
+			for _, server := range s.servers.items {
+				server.PrivateNet = slices.DeleteFunc(server.PrivateNet, func(n schema.ServerPrivateNet) bool { return n.Network == o.ID })
+			}
+			return nil
+		},
+		commands: map[string]command[schema.Network]{
+			"add_subnet": func(o *schema.Network, r *http.Request) (func(), error) {
+				body := schema.NetworkActionAddSubnetRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				if slices.ContainsFunc(o.Subnets, func(n schema.NetworkSubnet) bool { return n.IPRange == body.IPRange }) {
+					return nil, newError(http.StatusConflict, hcloud.ErrorCodeNetworksOverlap, "subnet %s already exists", body.IPRange)
+				}
+				o.Subnets = append(o.Subnets, schema.NetworkSubnet{
+					Type:        body.Type,
+					IPRange:     body.IPRange,
+					NetworkZone: body.NetworkZone,
+					VSwitchID:   body.VSwitchID,
+				})
+				return nil, nil
+			},
+			"delete_subnet": func(o *schema.Network, r *http.Request) (func(), error) {
+				body := schema.NetworkActionDeleteSubnetRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.Subnets = slices.DeleteFunc(o.Subnets, func(n schema.NetworkSubnet) bool { return n.IPRange == body.IPRange })
+				return nil, nil
+			},
+			"add_route": func(o *schema.Network, r *http.Request) (func(), error) {
+				body := schema.NetworkActionAddRouteRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.Routes = append(o.Routes, schema.NetworkRoute{Destination: body.Destination, Gateway: body.Gateway})
+				return nil, nil
+			},
+			"delete_route": func(o *schema.Network, r *http.Request) (func(), error) {
+				body := schema.NetworkActionDeleteRouteRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.Routes = slices.DeleteFunc(o.Routes, func(n schema.NetworkRoute) bool {
+					return n.Destination == body.Destination && n.Gateway == body.Gateway
+				})
+				return nil, nil
+			},
+			"change_ip_range": func(o *schema.Network, r *http.Request) (func(), error) {
+				body := schema.NetworkActionChangeIPRangeRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.IPRange = body.IPRange
+				return nil, nil
+			},
+		},
+	}
+	s.networks.register()
+}
+
+func (s *Server) createNetwork(r *http.Request) (response, error) {
+	body := schema.NetworkCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if err := s.networks.checkName(body.Name, 0); err != nil {
+		return response{}, err
+	}
+	if body.IPRange == "" {
+		return response{}, errInvalidInput("ip_range is required")
+	}
+
+	network := &schema.Network{
+		ID:                    s.networks.newID(),
+		Name:                  body.Name,
+		IPRange:               body.IPRange,
+		Subnets:               body.Subnets,
+		Routes:                body.Routes,
+		Servers:               []int64{},
+		LoadBalancers:         []int64{},
+		Labels:                labelsOrEmpty(body.Labels),
+		ExposeRoutesToVSwitch: body.ExposeRoutesToVSwitch,
+		Created:               s.now(),
+	}
+	if network.Subnets == nil {
+		network.Subnets = []schema.NetworkSubnet{}
+	}
+	if network.Routes == nil {
+		network.Routes = []schema.NetworkRoute{}
+	}
+	s.networks.insert(network)
+
+	return response{http.StatusCreated, schema.NetworkCreateResponse{Network: *network}}, nil
+}
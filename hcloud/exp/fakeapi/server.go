@@ -0,0 +1,280 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func (s *Server) registerServers() {
+	s.servers = &kind[schema.Server]{
+		server:   s,
+		path:     "servers",
+		key:      "server",
+		resource: hcloud.ActionResourceTypeServer,
+		id:       func(o *schema.Server) int64 { return o.ID },
+		name:     func(o *schema.Server) string { return o.Name },
+		labels:   func(o *schema.Server) map[string]string { return o.Labels },
+		create:   s.createServer,
+		onDelete: s.deleteServer,
+		commands: map[string]command[schema.Server]{
+			"poweron":  s.serverStatusCommand(hcloud.ServerStatusStarting, hcloud.ServerStatusRunning),
+			"reboot":   s.serverStatusCommand(hcloud.ServerStatusRunning, hcloud.ServerStatusRunning),
+			"reset":    s.serverStatusCommand(hcloud.ServerStatusRunning, hcloud.ServerStatusRunning),
+			"shutdown": s.serverStatusCommand(hcloud.ServerStatusStopping, hcloud.ServerStatusOff),
+			"poweroff": s.serverStatusCommand(hcloud.ServerStatusStopping, hcloud.ServerStatusOff),
+			"change_type": func(o *schema.Server, r *http.Request) (func(), error) {
+				body := schema.ServerActionChangeTypeRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				if o.Status != string(hcloud.ServerStatusOff) {
+					return nil, newError(http.StatusPreconditionFailed, hcloud.ErrorCodeServerNotStopped, "server must be stopped")
+				}
+				o.ServerType = serverTypeFromIDOrName(body.ServerType)
+				return nil, nil
+			},
+			"attach_to_network": func(o *schema.Server, r *http.Request) (func(), error) {
+				body := schema.ServerActionAttachToNetworkRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				return nil, s.attachServerToNetwork(o, body.Network)
+			},
+			"detach_from_network": func(o *schema.Server, r *http.Request) (func(), error) {
+				body := schema.ServerActionDetachFromNetworkRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.PrivateNet = slices.DeleteFunc(o.PrivateNet, func(n schema.ServerPrivateNet) bool { return n.Network == body.Network })
+				if network, ok := s.networks.items[body.Network]; ok {
+					network.Servers = deleteID(network.Servers, o.ID)
+				}
+				return nil, nil
+			},
+		},
+	}
+	s.servers.register()
+}
+
+func (s *Server) createServer(r *http.Request) (response, error) {
+	body := schema.ServerCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if err := s.servers.checkName(body.Name, 0); err != nil {
+		return response{}, err
+	}
+	if body.ServerType.ID == 0 && body.ServerType.Name == "" {
+		return response{}, errInvalidInput("server_type is required")
+	}
+	if body.Image.ID == 0 && body.Image.Name == "" {
+		return response{}, errInvalidInput("image is required")
+	}
+
+	location := body.Location
+	if location == "" {
+		location = "fsn1"
+	}
+
+	server := &schema.Server{
+		ID:            s.servers.newID(),
+		Name:          body.Name,
+		Status:        string(hcloud.ServerStatusInitializing),
+		Created:       s.now(),
+		ServerType:    serverTypeFromIDOrName(body.ServerType),
+		Image:         imageFromIDOrName(body.Image),
+		Location:      schema.Location{Name: location},
+		Labels:        labelsOrEmpty(body.Labels),
+		PrivateNet:    []schema.ServerPrivateNet{},
+		Volumes:       []int64{},
+		LoadBalancers: []int64{},
+		PublicNet: schema.ServerPublicNet{
+			FloatingIPs: []int64{},
+			Firewalls:   []schema.ServerFirewall{},
+		},
+	}
+
+	publicNet := body.PublicNet
+	if publicNet == nil {
+		publicNet = &schema.ServerCreatePublicNet{EnableIPv4: true, EnableIPv6: true}
+	}
+
+	// Validate the referenced resources before changing any state.
+	for _, id := range body.Networks {
+		if _, err := s.networks.get(id); err != nil {
+			return response{}, err
+		}
+	}
+	for _, id := range body.Volumes {
+		volume, err := s.volumes.get(id)
+		if err != nil {
+			return response{}, err
+		}
+		if volume.Server != nil {
+			return response{}, newError(http.StatusConflict, hcloud.ErrorCodeVolumeAlreadyAttached, "volume %d is already attached", id)
+		}
+	}
+	for _, fw := range body.Firewalls {
+		if _, err := s.firewalls.get(fw.Firewall); err != nil {
+			return response{}, err
+		}
+	}
+	if publicNet.EnableIPv4 && publicNet.IPv4ID != 0 {
+		if err := s.checkServerPrimaryIP(publicNet.IPv4ID, "ipv4"); err != nil {
+			return response{}, err
+		}
+	}
+	if publicNet.EnableIPv6 && publicNet.IPv6ID != 0 {
+		if err := s.checkServerPrimaryIP(publicNet.IPv6ID, "ipv6"); err != nil {
+			return response{}, err
+		}
+	}
+
+	if publicNet.EnableIPv4 {
+		ip := s.assignServerPrimaryIP(server, "ipv4", publicNet.IPv4ID)
+		server.PublicNet.IPv4 = schema.ServerPublicNetIPv4{ID: ip.ID, IP: ip.IP}
+	}
+	if publicNet.EnableIPv6 {
+		ip := s.assignServerPrimaryIP(server, "ipv6", publicNet.IPv6ID)
+		server.PublicNet.IPv6 = schema.ServerPublicNetIPv6{ID: ip.ID, IP: ip.IP, DNSPtr: []schema.ServerPublicNetIPv6DNSPtr{}}
+	}
+	for _, id := range body.Networks {
+		if err := s.attachServerToNetwork(server, id); err != nil {
+			return response{}, err
+		}
+	}
+	for _, id := range body.Volumes {
+		s.volumes.items[id].Server = &server.ID
+		server.Volumes = append(server.Volumes, id)
+	}
+	for _, fw := range body.Firewalls {
+		server.PublicNet.Firewalls = append(server.PublicNet.Firewalls, schema.ServerFirewall{ID: fw.Firewall, Status: "applied"})
+	}
+
+	s.servers.insert(server)
+
+	status := hcloud.ServerStatusRunning
+	if body.StartAfterCreate != nil && !*body.StartAfterCreate {
+		status = hcloud.ServerStatusOff
+	}
+	a := s.servers.startAction("create_server", server, func() {
+		server.Status = string(status)
+	})
+
+	rootPassword := "fake-root-password"
+	return response{http.StatusCreated, schema.ServerCreateResponse{
+		Server:       *server,
+		Action:       a.Action,
+		RootPassword: &rootPassword,
+		NextActions:  []schema.Action{},
+	}}, nil
+}
+
+func (s *Server) deleteServer(server *schema.Server) *action {
+	for _, ip := range s.primaryIPs.sorted() {
+		if ip.AssigneeID == nil || *ip.AssigneeID != server.ID {
+			continue
+		}
+		if ip.AutoDelete {
+			delete(s.primaryIPs.items, ip.ID)
+		} else {
+			ip.AssigneeID = nil
+		}
+	}
+	for _, id := range server.Volumes {
+		if volume, ok := s.volumes.items[id]; ok {
+			volume.Server = nil
+		}
+	}
+	for _, ip := range s.floatingIPs.items {
+		if ip.Server != nil && *ip.Server == server.ID {
+			ip.Server = nil
+		}
+	}
+	for _, network := range s.networks.items {
+		network.Servers = deleteID(network.Servers, server.ID)
+	}
+
+	server.Status = string(hcloud.ServerStatusDeleting)
+	return s.servers.startAction("delete_server", server, nil)
+}
+
+// serverStatusCommand returns a command that sets the server status while the action is
+// running, and once it completed.
+func (s *Server) serverStatusCommand(running, done hcloud.ServerStatus) command[schema.Server] {
+	return func(o *schema.Server, _ *http.Request) (func(), error) {
+		o.Status = string(running)
+		return func() { o.Status = string(done) }, nil
+	}
+}
+
+func (s *Server) attachServerToNetwork(server *schema.Server, id int64) error {
+	network, err := s.networks.get(id)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(server.PrivateNet, func(n schema.ServerPrivateNet) bool { return n.Network == id }) {
+		return newError(http.StatusConflict, hcloud.ErrorCodeServerAlreadyAttached, "server is already attached to network %d", id)
+	}
+
+	network.Servers = append(network.Servers, server.ID)
+	server.PrivateNet = append(server.PrivateNet, schema.ServerPrivateNet{
+		Network:  id,
+		IP:       fmt.Sprintf("10.0.0.%d", len(network.Servers)+1),
+		AliasIPs: []string{},
+	})
+	return nil
+}
+
+// checkServerPrimaryIP returns an error if the primary IP cannot be assigned to a server.
+func (s *Server) checkServerPrimaryIP(id int64, ipType string) error {
+	ip, err := s.primaryIPs.get(id)
+	if err != nil {
+		return err
+	}
+	if ip.AssigneeID != nil {
+		return newError(http.StatusConflict, hcloud.ErrorCodePrimaryIPAssigned, "primary ip %d is already assigned", id)
+	}
+	if ip.Type != ipType {
+		return newError(http.StatusBadRequest, hcloud.ErrorCodePrimaryIPVersionMismatch, "primary ip %d is not of type %s", id, ipType)
+	}
+	return nil
+}
+
+// assignServerPrimaryIP assigns the existing primary IP to the server, or creates a new
+// primary IP when the ID is 0.
+func (s *Server) assignServerPrimaryIP(server *schema.Server, ipType string, id int64) *schema.PrimaryIP {
+	if id == 0 {
+		ip := s.newPrimaryIP(fmt.Sprintf("primary_ip-%d", s.primaryIPs.nextID+1), ipType, server.Location)
+		ip.AutoDelete = true
+		id = ip.ID
+	}
+
+	ip := s.primaryIPs.items[id]
+	ip.AssigneeID = &server.ID
+	ip.AssigneeType = "server"
+	return ip
+}
+
+func serverTypeFromIDOrName(v schema.IDOrName) schema.ServerType {
+	return schema.ServerType{ID: v.ID, Name: v.Name}
+}
+
+func imageFromIDOrName(v schema.IDOrName) *schema.Image {
+	image := &schema.Image{ID: v.ID, Labels: map[string]string{}}
+	if v.Name != "" {
+		image.Name = &v.Name
+	}
+	return image
+}
+
+func labelsOrEmpty(labels *map[string]string) map[string]string {
+	if labels == nil || *labels == nil {
+		return map[string]string{}
+	}
+	return *labels
+}
@@ -0,0 +1,111 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func (s *Server) registerStorageBoxes() {
+	s.storageBoxes = &kind[schema.StorageBox]{
+		server:   s,
+		path:     "storage_boxes",
+		key:      "storage_box",
+		resource: hcloud.ActionResourceTypeStorageBox,
+		id:       func(o *schema.StorageBox) int64 { return o.ID },
+		name:     func(o *schema.StorageBox) string { return o.Name },
+		labels:   func(o *schema.StorageBox) map[string]string { return o.Labels },
+		create:   s.createStorageBox,
+		onDelete: func(o *schema.StorageBox) *action {
+			return s.storageBoxes.startAction("delete_storage_box", o, nil)
+		},
+		commands: map[string]command[schema.StorageBox]{
+			"change_type": func(o *schema.StorageBox, r *http.Request) (func(), error) {
+				body := schema.StorageBoxChangeTypeRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.StorageBoxType = schema.StorageBoxType{ID: body.StorageBoxType.ID, Name: body.StorageBoxType.Name}
+				return nil, nil
+			},
+			"update_access_settings": func(o *schema.StorageBox, r *http.Request) (func(), error) {
+				body := map[string]json.RawMessage{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				settings, err := merge(&o.AccessSettings, body)
+				if err != nil {
+					return nil, err
+				}
+				o.AccessSettings = *settings
+				return nil, nil
+			},
+			"enable_snapshot_plan": func(o *schema.StorageBox, r *http.Request) (func(), error) {
+				body := schema.StorageBoxEnableSnapshotPlanRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				plan, err := convert[schema.StorageBoxSnapshotPlan](body)
+				if err != nil {
+					return nil, err
+				}
+				o.SnapshotPlan = &plan
+				return nil, nil
+			},
+			"disable_snapshot_plan": func(o *schema.StorageBox, _ *http.Request) (func(), error) {
+				o.SnapshotPlan = nil
+				return nil, nil
+			},
+		},
+	}
+	s.storageBoxes.register()
+}
+
+func (s *Server) createStorageBox(r *http.Request) (response, error) {
+	body := schema.StorageBoxCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if err := s.storageBoxes.checkName(body.Name, 0); err != nil {
+		return response{}, err
+	}
+	if body.StorageBoxType.ID == 0 && body.StorageBoxType.Name == "" {
+		return response{}, errInvalidInput("storage_box_type is required")
+	}
+	if body.Password == "" {
+		return response{}, errInvalidInput("password is required")
+	}
+
+	settings, err := convert[schema.StorageBoxAccessSettings](body.AccessSettings)
+	if err != nil {
+		return response{}, err
+	}
+
+	id := s.storageBoxes.newID()
+	username := fmt.Sprintf("u%d", id)
+	server := fmt.Sprintf("%s.your-storagebox.de", username)
+	system := "FSN1-BX1"
+
+	box := &schema.StorageBox{
+		ID:             id,
+		Username:       &username,
+		Status:         "initializing",
+		Name:           body.Name,
+		StorageBoxType: schema.StorageBoxType{ID: body.StorageBoxType.ID, Name: body.StorageBoxType.Name},
+		Location:       schema.Location{ID: body.Location.ID, Name: body.Location.Name},
+		AccessSettings: settings,
+		Server:         &server,
+		System:         &system,
+		Labels:         labelsOrEmpty(body.Labels),
+		Created:        s.now(),
+	}
+	s.storageBoxes.insert(box)
+
+	a := s.storageBoxes.startAction("create_storage_box", box, func() {
+		box.Status = "active"
+	})
+	return response{http.StatusCreated, schema.StorageBoxCreateResponse{StorageBox: *box, Action: a.Action}}, nil
+}
@@ -0,0 +1,125 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func (s *Server) registerVolumes() {
+	s.volumes = &kind[schema.Volume]{
+		server:   s,
+		path:     "volumes",
+		key:      "volume",
+		resource: hcloud.ActionResourceTypeVolume,
+		id:       func(o *schema.Volume) int64 { return o.ID },
+		name:     func(o *schema.Volume) string { return o.Name },
+		labels:   func(o *schema.Volume) map[string]string { return o.Labels },
+		create:   s.createVolume,
+		onDelete: func(o *schema.Volume) *action {
+			s.detachVolume(o)
+			return nil
+		},
+		commands: map[string]command[schema.Volume]{
+			"attach": func(o *schema.Volume, r *http.Request) (func(), error) {
+				body := schema.VolumeActionAttachVolumeRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				if o.Server != nil {
+					return nil, newError(http.StatusConflict, hcloud.ErrorCodeVolumeAlreadyAttached, "volume is already attached")
+				}
+				server, err := s.servers.get(body.Server)
+				if err != nil {
+					return nil, err
+				}
+				o.Server = &server.ID
+				server.Volumes = append(server.Volumes, o.ID)
+				return nil, nil
+			},
+			"detach": func(o *schema.Volume, _ *http.Request) (func(), error) {
+				s.detachVolume(o)
+				return nil, nil
+			},
+			"resize": func(o *schema.Volume, r *http.Request) (func(), error) {
+				body := schema.VolumeActionResizeVolumeRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				if body.Size < o.Size {
+					return nil, errInvalidInput("size must be greater than the current size")
+				}
+				o.Size = body.Size
+				return nil, nil
+			},
+		},
+	}
+	s.volumes.register()
+}
+
+func (s *Server) createVolume(r *http.Request) (response, error) {
+	body := schema.VolumeCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if err := s.volumes.checkName(body.Name, 0); err != nil {
+		return response{}, err
+	}
+	if body.Size < 10 {
+		return response{}, errInvalidInput("size must be at least 10")
+	}
+
+	var server *schema.Server
+	location := "fsn1"
+	switch {
+	case body.Server != nil:
+		var err error
+		if server, err = s.servers.get(*body.Server); err != nil {
+			return response{}, err
+		}
+		location = server.Location.Name
+	case body.Location != nil && body.Location.Name != "":
+		location = body.Location.Name
+	}
+
+	id := s.volumes.newID()
+	volume := &schema.Volume{
+		ID:          id,
+		Name:        body.Name,
+		Status:      string(hcloud.VolumeStatusCreating),
+		Size:        body.Size,
+		Format:      body.Format,
+		Location:    schema.Location{Name: location},
+		Labels:      labelsOrEmpty(body.Labels),
+		LinuxDevice: fmt.Sprintf("/dev/disk/by-id/scsi-0HC_Volume_%d", id),
+		Created:     s.now(),
+	}
+	s.volumes.insert(volume)
+
+	a := s.volumes.startAction("create_volume", volume, func() {
+		volume.Status = string(hcloud.VolumeStatusAvailable)
+	})
+	resp := schema.VolumeCreateResponse{Action: &a.Action, NextActions: []schema.Action{}}
+
+	if server != nil {
+		volume.Server = &server.ID
+		server.Volumes = append(server.Volumes, volume.ID)
+		next := s.actions.start("attach_volume", nil, s.volumes.ref(volume), s.servers.ref(server))
+		resp.NextActions = append(resp.NextActions, next.Action)
+	}
+
+	resp.Volume = *volume
+	return response{http.StatusCreated, resp}, nil
+}
+
+func (s *Server) detachVolume(volume *schema.Volume) {
+	if volume.Server == nil {
+		return
+	}
+	if server, ok := s.servers.items[*volume.Server]; ok {
+		server.Volumes = deleteID(server.Volumes, volume.ID)
+	}
+	volume.Server = nil
+}
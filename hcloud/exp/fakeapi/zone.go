@@ -0,0 +1,327 @@
+package fakeapi
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/labelutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// defaultNameservers are the authoritative nameservers assigned to the zones.
+var defaultNameservers = []string{"hydrogen.ns.hetzner.com.", "oxygen.ns.hetzner.com.", "helium.ns.hetzner.de."}
+
+func (s *Server) registerZones() {
+	s.zones = &kind[schema.Zone]{
+		server:   s,
+		path:     "zones",
+		key:      "zone",
+		resource: hcloud.ActionResourceTypeZone,
+		byName:   true,
+		id:       func(o *schema.Zone) int64 { return o.ID },
+		name:     func(o *schema.Zone) string { return o.Name },
+		labels:   func(o *schema.Zone) map[string]string { return o.Labels },
+		create:   s.createZone,
+		onDelete: func(o *schema.Zone) *action {
+			delete(s.rrsets, o.ID)
+			return s.zones.startAction("delete_zone", o, nil)
+		},
+		commands: map[string]command[schema.Zone]{
+			"change_ttl": func(o *schema.Zone, r *http.Request) (func(), error) {
+				body := schema.ZoneChangeTTLRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				o.TTL = body.TTL
+				return nil, nil
+			},
+			"change_primary_nameservers": func(o *schema.Zone, r *http.Request) (func(), error) {
+				body := schema.ZoneChangePrimaryNameserversRequest{}
+				if err := decodeBody(r, &body); err != nil {
+					return nil, err
+				}
+				nameservers, err := convert[[]schema.ZonePrimaryNameserver](body.PrimaryNameservers)
+				if err != nil {
+					return nil, err
+				}
+				o.PrimaryNameservers = nameservers
+				return nil, nil
+			},
+		},
+	}
+	s.zones.register()
+
+	s.handle("GET /zones/{zone}/rrsets", s.listRRSets)
+	s.handle("POST /zones/{zone}/rrsets", s.createRRSet)
+	s.handle("GET /zones/{zone}/rrsets/{name}/{type}", func(r *http.Request) (response, error) {
+		_, rrset, err := s.lookupRRSet(r)
+		if err != nil {
+			return response{}, err
+		}
+		return response{http.StatusOK, schema.ZoneRRSetGetResponse{RRSet: *rrset}}, nil
+	})
+	s.handle("PUT /zones/{zone}/rrsets/{name}/{type}", func(r *http.Request) (response, error) {
+		_, rrset, err := s.lookupRRSet(r)
+		if err != nil {
+			return response{}, err
+		}
+		body := schema.ZoneRRSetUpdateRequest{}
+		if err := decodeBody(r, &body); err != nil {
+			return response{}, err
+		}
+		if body.Labels != nil {
+			rrset.Labels = labelsOrEmpty(body.Labels)
+		}
+		return response{http.StatusOK, schema.ZoneRRSetUpdateResponse{RRSet: *rrset}}, nil
+	})
+	s.handle("DELETE /zones/{zone}/rrsets/{name}/{type}", func(r *http.Request) (response, error) {
+		zone, rrset, err := s.lookupRRSet(r)
+		if err != nil {
+			return response{}, err
+		}
+		if rrset.Protection.Change {
+			return response{}, newError(http.StatusLocked, hcloud.ErrorCodeProtected, "rrset is change protected")
+		}
+		s.rrsets[zone.ID] = slices.DeleteFunc(s.rrsets[zone.ID], func(o *schema.ZoneRRSet) bool { return o == rrset })
+		zone.RecordCount = s.recordCount(zone.ID)
+		return actionResponse(s.zones.startAction("delete_rrset", zone, nil)), nil
+	})
+	s.handle("POST /zones/{zone}/rrsets/{name}/{type}/actions/{command}", s.handleRRSetCommand)
+}
+
+func (s *Server) createZone(r *http.Request) (response, error) {
+	body := schema.ZoneCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if err := s.zones.checkName(body.Name, 0); err != nil {
+		return response{}, err
+	}
+	mode := cmp.Or(body.Mode, "primary")
+	if mode != "primary" && mode != "secondary" {
+		return response{}, errInvalidInput("invalid mode: %s", mode)
+	}
+
+	nameservers, err := convert[[]schema.ZonePrimaryNameserver](body.PrimaryNameservers)
+	if err != nil {
+		return response{}, err
+	}
+	if nameservers == nil {
+		nameservers = []schema.ZonePrimaryNameserver{}
+	}
+
+	zone := &schema.Zone{
+		ID:                 s.zones.newID(),
+		Name:               body.Name,
+		Created:            s.now(),
+		TTL:                3600,
+		Mode:               mode,
+		PrimaryNameservers: nameservers,
+		Labels:             labelsOrEmpty(body.Labels),
+		AuthoritativeNameservers: schema.ZoneAuthoritativeNameservers{
+			Assigned:         slices.Clone(defaultNameservers),
+			Delegated:        []string{},
+			DelegationStatus: "unknown",
+		},
+		Status: "ok",
+	}
+	if body.TTL != nil {
+		zone.TTL = *body.TTL
+	}
+
+	rrsets := make([]*schema.ZoneRRSet, 0, len(body.RRSets)+2)
+	if mode == "primary" {
+		nsRecords := make([]schema.ZoneRRSetRecord, 0, len(defaultNameservers))
+		for _, ns := range defaultNameservers {
+			nsRecords = append(nsRecords, schema.ZoneRRSetRecord{Value: ns})
+		}
+		rrsets = append(rrsets,
+			newRRSet(zone.ID, "@", "NS", nil, nil, nsRecords),
+			newRRSet(zone.ID, "@", "SOA", nil, nil, []schema.ZoneRRSetRecord{
+				{Value: defaultNameservers[0] + " dns.hetzner.com. 1 86400 10800 3600000 3600"},
+			}),
+		)
+	}
+	for _, o := range body.RRSets {
+		if slices.ContainsFunc(rrsets, func(rrset *schema.ZoneRRSet) bool { return rrset.Name == o.Name && rrset.Type == o.Type }) {
+			return response{}, newError(http.StatusConflict, hcloud.ErrorCodeUniquenessError, "rrset %s/%s is defined twice", o.Name, o.Type)
+		}
+		rrsets = append(rrsets, newRRSet(zone.ID, o.Name, o.Type, o.TTL, o.Labels, o.Records))
+	}
+
+	s.zones.insert(zone)
+	s.rrsets[zone.ID] = rrsets
+	zone.RecordCount = s.recordCount(zone.ID)
+
+	a := s.zones.startAction("create_zone", zone, nil)
+	return response{http.StatusCreated, schema.ZoneCreateResponse{Zone: *zone, Action: a.Action}}, nil
+}
+
+func newRRSet(zone int64, name, rrsetType string, ttl *int, labels *map[string]string, records []schema.ZoneRRSetRecord) *schema.ZoneRRSet {
+	if records == nil {
+		records = []schema.ZoneRRSetRecord{}
+	}
+	return &schema.ZoneRRSet{
+		ID:      name + "/" + rrsetType,
+		Name:    name,
+		Type:    rrsetType,
+		TTL:     ttl,
+		Labels:  labelsOrEmpty(labels),
+		Records: records,
+		Zone:    zone,
+	}
+}
+
+func (s *Server) recordCount(zone int64) int {
+	count := 0
+	for _, rrset := range s.rrsets[zone] {
+		count += len(rrset.Records)
+	}
+	return count
+}
+
+// lookupRRSet returns the zone and the RRSet referenced in the request path.
+func (s *Server) lookupRRSet(r *http.Request) (*schema.Zone, *schema.ZoneRRSet, error) {
+	zone, err := s.zones.lookup(r.PathValue("zone"))
+	if err != nil {
+		return nil, nil, err
+	}
+	i := slices.IndexFunc(s.rrsets[zone.ID], func(o *schema.ZoneRRSet) bool {
+		return o.Name == r.PathValue("name") && o.Type == r.PathValue("type")
+	})
+	if i < 0 {
+		return nil, nil, errNotFound("rrset")
+	}
+	return zone, s.rrsets[zone.ID][i], nil
+}
+
+func (s *Server) listRRSets(r *http.Request) (response, error) {
+	zone, err := s.zones.lookup(r.PathValue("zone"))
+	if err != nil {
+		return response{}, err
+	}
+
+	query := r.URL.Query()
+	matcher, err := labelutil.ParseSelector(query.Get("label_selector"))
+	if err != nil {
+		return response{}, errInvalidInput("%s", err)
+	}
+
+	result := make([]*schema.ZoneRRSet, 0)
+	for _, rrset := range s.rrsets[zone.ID] {
+		if name := query.Get("name"); name != "" && rrset.Name != name {
+			continue
+		}
+		if types := query["type"]; len(types) > 0 && !slices.Contains(types, rrset.Type) {
+			continue
+		}
+		if !matcher.Matches(rrset.Labels) {
+			continue
+		}
+		result = append(result, rrset)
+	}
+	slices.SortFunc(result, func(a, b *schema.ZoneRRSet) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Type, b.Type))
+	})
+
+	page, meta, err := paginate(r, result)
+	if err != nil {
+		return response{}, err
+	}
+	return response{http.StatusOK, map[string]any{"rrsets": page, "meta": meta}}, nil
+}
+
+func (s *Server) createRRSet(r *http.Request) (response, error) {
+	zone, err := s.zones.lookup(r.PathValue("zone"))
+	if err != nil {
+		return response{}, err
+	}
+	body := schema.ZoneRRSetCreateRequest{}
+	if err := decodeBody(r, &body); err != nil {
+		return response{}, err
+	}
+	if body.Name == "" || body.Type == "" {
+		return response{}, errInvalidInput("name and type are required")
+	}
+	if slices.ContainsFunc(s.rrsets[zone.ID], func(o *schema.ZoneRRSet) bool { return o.Name == body.Name && o.Type == body.Type }) {
+		return response{}, newError(http.StatusConflict, hcloud.ErrorCodeUniquenessError, "rrset %s/%s already exists", body.Name, body.Type)
+	}
+
+	rrset := newRRSet(zone.ID, body.Name, body.Type, body.TTL, body.Labels, body.Records)
+	s.rrsets[zone.ID] = append(s.rrsets[zone.ID], rrset)
+	zone.RecordCount = s.recordCount(zone.ID)
+
+	a := s.zones.startAction("create_rrset", zone, nil)
+	return response{http.StatusCreated, schema.ZoneRRSetCreateResponse{RRSet: *rrset, Action: a.Action}}, nil
+}
+
+func (s *Server) handleRRSetCommand(r *http.Request) (response, error) {
+	zone, rrset, err := s.lookupRRSet(r)
+	if err != nil {
+		return response{}, err
+	}
+
+	name := r.PathValue("command")
+	if rrset.Protection.Change && name != "change_protection" {
+		return response{}, newError(http.StatusLocked, hcloud.ErrorCodeProtected, "rrset is change protected")
+	}
+
+	switch name {
+	case "change_protection":
+		err = changeProtection(rrset, r)
+	case "change_ttl":
+		body := schema.ZoneRRSetChangeTTLRequest{}
+		if err = decodeBody(r, &body); err == nil {
+			rrset.TTL = body.TTL
+		}
+	case "set_records":
+		body := schema.ZoneRRSetSetRecordsRequest{}
+		if err = decodeBody(r, &body); err == nil {
+			rrset.Records = body.Records
+		}
+	case "add_records":
+		body := schema.ZoneRRSetAddRecordsRequest{}
+		if err = decodeBody(r, &body); err == nil {
+			for _, record := range body.Records {
+				if !slices.ContainsFunc(rrset.Records, func(o schema.ZoneRRSetRecord) bool { return o.Value == record.Value }) {
+					rrset.Records = append(rrset.Records, record)
+				}
+			}
+			if body.TTL != nil {
+				rrset.TTL = body.TTL
+			}
+		}
+	case "update_records":
+		body := schema.ZoneRRSetUpdateRecordsRequest{}
+		if err = decodeBody(r, &body); err == nil {
+			for _, record := range body.Records {
+				i := slices.IndexFunc(rrset.Records, func(o schema.ZoneRRSetRecord) bool { return o.Value == record.Value })
+				if i < 0 {
+					err = errNotFound("record")
+					break
+				}
+				rrset.Records[i].Comment = record.Comment
+			}
+		}
+	case "remove_records":
+		body := schema.ZoneRRSetRemoveRecordsRequest{}
+		if err = decodeBody(r, &body); err == nil {
+			rrset.Records = slices.DeleteFunc(rrset.Records, func(o schema.ZoneRRSetRecord) bool {
+				return slices.ContainsFunc(body.Records, func(record schema.ZoneRRSetRecord) bool { return o.Value == record.Value })
+			})
+			if len(rrset.Records) == 0 {
+				s.rrsets[zone.ID] = slices.DeleteFunc(s.rrsets[zone.ID], func(o *schema.ZoneRRSet) bool { return o == rrset })
+			}
+		}
+	default:
+		err = errNotFound("action")
+	}
+	if err != nil {
+		return response{}, err
+	}
+
+	zone.RecordCount = s.recordCount(zone.ID)
+	return actionResponse(s.zones.startAction(name, zone, nil)), nil
+}
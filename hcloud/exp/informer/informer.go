@@ -0,0 +1,397 @@
+// Package informer keeps an in-memory, indexed snapshot of the resources of a project,
+// and notifies about the added, updated and deleted resources.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+package informer
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/labelutil"
+)
+
+// Source describes how to fetch and index the resources of an [Informer].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Source[T any] struct {
+	// Type is the type of the resources in the [hcloud.Action] resources.
+	Type hcloud.ActionResourceType
+	// List returns all the resources matching the label selector.
+	List func(ctx context.Context, labelSelector string) ([]*T, error)
+	// Get returns the resource with the ID, or nil if it does not exist.
+	Get func(ctx context.Context, id int64) (*T, error)
+
+	ID     func(*T) int64
+	Name   func(*T) string
+	Labels func(*T) map[string]string
+}
+
+// Opts defines the options used by [New].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Opts struct {
+	// ResyncPeriod is the interval at which all the resources are listed again. Defaults
+	// to 5 minutes.
+	ResyncPeriod time.Duration
+	// LabelSelector restricts the resources held by the informer.
+	LabelSelector string
+	// OnError is called when fetching the resources failed in [Informer.Run]. The
+	// resources are fetched again at the next resync.
+	OnError func(err error)
+}
+
+// EventHandler is notified about the changes of the resources. Unset functions are
+// ignored.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type EventHandler[T any] struct {
+	OnAdd    func(obj *T)
+	OnUpdate func(oldObj, newObj *T)
+	OnDelete func(obj *T)
+}
+
+type eventType int
+
+const (
+	eventAdd eventType = iota
+	eventUpdate
+	eventDelete
+)
+
+type event[T any] struct {
+	typ    eventType
+	oldObj *T
+	newObj *T
+}
+
+// Informer holds an in-memory snapshot of resources, indexed by ID, name and labels.
+//
+// The snapshot is refreshed periodically by [Informer.Run], and the changes between two
+// snapshots are sent to the event handlers. Single resources may be refreshed early
+// using [Informer.Refresh] or [Informer.RefreshForActions].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Informer[T any] struct {
+	source  Source[T]
+	opts    Opts
+	matcher *labelutil.Matcher
+
+	mu       sync.RWMutex
+	items    map[int64]*T
+	names    map[string]int64
+	synced   bool
+	handlers []EventHandler[T]
+
+	// generation is incremented when a resync starts. The generation of the last
+	// applied resync, and the generations in which resources were refreshed, prevent
+	// overwriting newer resources with the ones of a slower resync.
+	generation       uint64
+	syncedGeneration uint64
+	refreshed        map[int64]uint64
+}
+
+// New creates a new [Informer], see [NewServerInformer] and the other constructors for
+// the supported resources.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func New[T any](source Source[T], opts Opts) (*Informer[T], error) {
+	if opts.ResyncPeriod <= 0 {
+		opts.ResyncPeriod = 5 * time.Minute
+	}
+
+	matcher, err := labelutil.ParseSelector(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Informer[T]{
+		source:    source,
+		opts:      opts,
+		matcher:   matcher,
+		items:     make(map[int64]*T),
+		names:     make(map[string]int64),
+		refreshed: make(map[int64]uint64),
+	}, nil
+}
+
+// AddEventHandler registers a handler to be notified about the changes of the
+// resources. The handlers are called sequentially, in the goroutine that refreshed the
+// resources.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) AddEventHandler(handler EventHandler[T]) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.handlers = append(i.handlers, handler)
+}
+
+// Run fetches the resources every [Opts.ResyncPeriod], until the context is canceled.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) Run(ctx context.Context) error {
+	ticker := time.NewTicker(i.opts.ResyncPeriod)
+	defer ticker.Stop()
+
+	for {
+		if err := i.Resync(ctx); err != nil && ctx.Err() == nil && i.opts.OnError != nil {
+			i.opts.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Resync fetches all the resources, replaces the snapshot, and sends the changes to the
+// event handlers.
+//
+// The resources refreshed while the resources were fetched are kept as is, as they may
+// be newer than the fetched ones.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) Resync(ctx context.Context) error {
+	i.mu.Lock()
+	i.generation++
+	generation := i.generation
+	i.mu.Unlock()
+
+	list, err := i.source.List(ctx, i.opts.LabelSelector)
+	if err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+
+	if generation < i.syncedGeneration {
+		// A later resync was already applied.
+		i.mu.Unlock()
+		return nil
+	}
+
+	events := make([]event[T], 0)
+	items := make(map[int64]*T, len(list))
+	names := make(map[string]int64, len(list))
+
+	for id, refreshed := range i.refreshed {
+		if refreshed < generation {
+			delete(i.refreshed, id)
+		} else if obj, ok := i.items[id]; ok {
+			items[id] = obj
+			names[i.source.Name(obj)] = id
+		}
+	}
+
+	for _, obj := range list {
+		id := i.source.ID(obj)
+		if _, ok := i.refreshed[id]; ok {
+			continue
+		}
+		items[id] = obj
+		names[i.source.Name(obj)] = id
+
+		if oldObj, ok := i.items[id]; !ok {
+			events = append(events, event[T]{typ: eventAdd, newObj: obj})
+		} else if !reflect.DeepEqual(oldObj, obj) {
+			events = append(events, event[T]{typ: eventUpdate, oldObj: oldObj, newObj: obj})
+		}
+	}
+	for id, oldObj := range i.items {
+		if _, ok := items[id]; !ok {
+			events = append(events, event[T]{typ: eventDelete, oldObj: oldObj})
+		}
+	}
+
+	i.items = items
+	i.names = names
+	i.synced = true
+	i.syncedGeneration = generation
+	handlers := i.handlers
+
+	i.mu.Unlock()
+
+	dispatch(handlers, events)
+	return nil
+}
+
+// Refresh fetches a single resource, updates the snapshot, and sends the change to the
+// event handlers.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) Refresh(ctx context.Context, id int64) error {
+	obj, err := i.source.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if obj != nil && !i.matcher.Matches(i.source.Labels(obj)) {
+		// The resource no longer matches the label selector.
+		obj = nil
+	}
+
+	i.mu.Lock()
+
+	i.refreshed[id] = i.generation
+
+	var events []event[T]
+	oldObj, ok := i.items[id]
+
+	switch {
+	case obj == nil && ok:
+		delete(i.items, id)
+		delete(i.names, i.source.Name(oldObj))
+		events = append(events, event[T]{typ: eventDelete, oldObj: oldObj})
+	case obj != nil && !ok:
+		i.items[id] = obj
+		i.names[i.source.Name(obj)] = id
+		events = append(events, event[T]{typ: eventAdd, newObj: obj})
+	case obj != nil && !reflect.DeepEqual(oldObj, obj):
+		delete(i.names, i.source.Name(oldObj))
+		i.items[id] = obj
+		i.names[i.source.Name(obj)] = id
+		events = append(events, event[T]{typ: eventUpdate, oldObj: oldObj, newObj: obj})
+	}
+	handlers := i.handlers
+
+	i.mu.Unlock()
+
+	dispatch(handlers, events)
+	return nil
+}
+
+// RefreshForActions refreshes the resources of the informer type that are referenced by
+// the actions, for example after waiting for the actions to complete.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) RefreshForActions(ctx context.Context, actions ...*hcloud.Action) error {
+	seen := make(map[int64]struct{})
+	errs := make([]error, 0)
+
+	for _, action := range actions {
+		for _, resource := range action.Resources {
+			if resource.Type != i.source.Type {
+				continue
+			}
+			if _, ok := seen[resource.ID]; ok {
+				continue
+			}
+			seen[resource.ID] = struct{}{}
+
+			if err := i.Refresh(ctx, resource.ID); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// HasSynced returns whether the resources were fetched at least once.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) HasSynced() bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.synced
+}
+
+// GetByID returns the resource with the ID from the snapshot, or nil if it does not
+// exist.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) GetByID(id int64) *T {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.items[id]
+}
+
+// GetByName returns the resource with the name from the snapshot, or nil if it does not
+// exist.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) GetByName(name string) *T {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	id, ok := i.names[name]
+	if !ok {
+		return nil
+	}
+	return i.items[id]
+}
+
+// List returns all the resources from the snapshot.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) List() []*T {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	result := make([]*T, 0, len(i.items))
+	for _, obj := range i.items {
+		result = append(result, obj)
+	}
+	i.sort(result)
+	return result
+}
+
+// ListBySelector returns the resources from the snapshot whose labels match the
+// [label selector](https://docs.hetzner.cloud/reference/cloud#label-selector).
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (i *Informer[T]) ListBySelector(selector string) ([]*T, error) {
+	matcher, err := labelutil.ParseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	result := make([]*T, 0)
+	for _, obj := range i.items {
+		if matcher.Matches(i.source.Labels(obj)) {
+			result = append(result, obj)
+		}
+	}
+	i.sort(result)
+	return result, nil
+}
+
+// sort orders the resources by ID, to return reproducible results.
+func (i *Informer[T]) sort(list []*T) {
+	slices.SortFunc(list, func(a, b *T) int {
+		return cmp.Compare(i.source.ID(a), i.source.ID(b))
+	})
+}
+
+func dispatch[T any](handlers []EventHandler[T], events []event[T]) {
+	for _, e := range events {
+		for _, h := range handlers {
+			switch e.typ {
+			case eventAdd:
+				if h.OnAdd != nil {
+					h.OnAdd(e.newObj)
+				}
+			case eventUpdate:
+				if h.OnUpdate != nil {
+					h.OnUpdate(e.oldObj, e.newObj)
+				}
+			case eventDelete:
+				if h.OnDelete != nil {
+					h.OnDelete(e.oldObj)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package informer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+)
+
+type recorder struct {
+	events []string
+}
+
+func (r *recorder) handler() EventHandler[hcloud.Server] {
+	return EventHandler[hcloud.Server]{
+		OnAdd:    func(obj *hcloud.Server) { r.events = append(r.events, "add "+obj.Name) },
+		OnUpdate: func(_, newObj *hcloud.Server) { r.events = append(r.events, "update "+newObj.Name) },
+		OnDelete: func(obj *hcloud.Server) { r.events = append(r.events, "delete "+obj.Name) },
+	}
+}
+
+func TestServerInformer(t *testing.T) {
+	ctx := context.Background()
+
+	server := mockutil.NewServer(t, []mockutil.Request{
+		{
+			Method: "GET", Path: "/servers?label_selector=env%3Dprod&page=1&per_page=50",
+			Status: 200,
+			JSONRaw: `{
+				"servers": [
+					{ "id": 1, "name": "web", "status": "running", "labels": { "env": "prod", "tier": "web" }},
+					{ "id": 2, "name": "db", "status": "running", "labels": { "env": "prod", "tier": "db" }}
+				]
+			}`,
+		},
+		{
+			Method: "GET", Path: "/servers?label_selector=env%3Dprod&page=1&per_page=50",
+			Status: 200,
+			JSONRaw: `{
+				"servers": [
+					{ "id": 1, "name": "web", "status": "off", "labels": { "env": "prod", "tier": "web" }},
+					{ "id": 3, "name": "cache", "status": "running", "labels": { "env": "prod", "tier": "cache" }}
+				]
+			}`,
+		},
+		{
+			Method: "GET", Path: "/servers/3",
+			Status: 200,
+			JSONRaw: `{
+				"server": { "id": 3, "name": "cache", "status": "running", "labels": { "env": "dev" }}
+			}`,
+		},
+		{
+			Method: "GET", Path: "/servers/1",
+			Status:  404,
+			JSONRaw: `{ "error": { "code": "not_found", "message": "server not found" }}`,
+		},
+	})
+
+	client := hcloud.NewClient(
+		hcloud.WithEndpoint(server.URL),
+		hcloud.WithRetryOpts(hcloud.RetryOpts{BackoffFunc: hcloud.ConstantBackoff(0), MaxRetries: 5}),
+	)
+
+	informer, err := NewServerInformer(client, Opts{LabelSelector: "env=prod"})
+	require.NoError(t, err)
+
+	r := &recorder{}
+	informer.AddEventHandler(r.handler())
+
+	require.False(t, informer.HasSynced())
+
+	// Initial sync
+	require.NoError(t, informer.Resync(ctx))
+	require.True(t, informer.HasSynced())
+	assert.Equal(t, []string{"add web", "add db"}, r.events)
+
+	assert.Equal(t, "web", informer.GetByID(1).Name)
+	assert.Equal(t, int64(2), informer.GetByName("db").ID)
+	assert.Nil(t, informer.GetByName("cache"))
+
+	servers, err := informer.ListBySelector("tier in (db,cache)")
+	require.NoError(t, err)
+	require.Len(t, servers, 1)
+	assert.Equal(t, "db", servers[0].Name)
+
+	// Second sync
+	r.events = nil
+	require.NoError(t, informer.Resync(ctx))
+	assert.Equal(t, []string{"update web", "add cache", "delete db"}, r.events)
+	assert.Len(t, informer.List(), 2)
+	assert.Equal(t, hcloud.ServerStatusOff, informer.GetByID(1).Status)
+
+	// Targeted refresh, server 3 no longer matches the label selector and server 1 was
+	// deleted
+	r.events = nil
+	require.NoError(t, informer.RefreshForActions(ctx,
+		&hcloud.Action{ID: 10, Resources: []*hcloud.ActionResource{
+			{ID: 3, Type: hcloud.ActionResourceTypeServer},
+			{ID: 5, Type: hcloud.ActionResourceTypeVolume},
+		}},
+		&hcloud.Action{ID: 11, Resources: []*hcloud.ActionResource{
+			{ID: 1, Type: hcloud.ActionResourceTypeServer},
+			{ID: 3, Type: hcloud.ActionResourceTypeServer},
+		}},
+	))
+	assert.Equal(t, []string{"delete cache", "delete web"}, r.events)
+	assert.Empty(t, informer.List())
+}
+
+func TestNewInvalidSelector(t *testing.T) {
+	_, err := New(Source[hcloud.Server]{}, Opts{LabelSelector: "env in prod"})
+	require.Error(t, err)
+}
+
+func TestResyncAfterRefresh(t *testing.T) {
+	ctx := context.Background()
+
+	type item struct {
+		ID      int64
+		Name    string
+		Version int
+	}
+
+	listing := make(chan struct{})
+	release := make(chan struct{})
+	versions := map[int64]int{1: 1, 2: 1}
+
+	informer, err := New(Source[item]{
+		List: func(_ context.Context, _ string) ([]*item, error) {
+			// The list is taken before the refresh, but returned after it.
+			list := []*item{{ID: 1, Name: "a", Version: versions[1]}, {ID: 2, Name: "b", Version: versions[2]}}
+			close(listing)
+			<-release
+			return list, nil
+		},
+		Get: func(_ context.Context, id int64) (*item, error) {
+			if id == 2 {
+				return nil, nil
+			}
+			return &item{ID: id, Name: "a", Version: versions[id]}, nil
+		},
+		ID:     func(i *item) int64 { return i.ID },
+		Name:   func(i *item) string { return i.Name },
+		Labels: func(*item) map[string]string { return nil },
+	}, Opts{})
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- informer.Resync(ctx) }()
+	<-listing
+
+	versions[1] = 2
+	require.NoError(t, informer.Refresh(ctx, 1))
+	require.NoError(t, informer.Refresh(ctx, 2))
+
+	close(release)
+	require.NoError(t, <-errCh)
+
+	// The refreshed resources are kept.
+	require.NotNil(t, informer.GetByID(1))
+	assert.Equal(t, 2, informer.GetByID(1).Version)
+	assert.Nil(t, informer.GetByID(2))
+}
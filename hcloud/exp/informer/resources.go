@@ -0,0 +1,83 @@
+package informer
+
+import (
+	"context"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// NewServerInformer creates an [Informer] for the servers of the project.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewServerInformer(client *hcloud.Client, opts Opts) (*Informer[hcloud.Server], error) {
+	return New(Source[hcloud.Server]{
+		Type: hcloud.ActionResourceTypeServer,
+		List: func(ctx context.Context, labelSelector string) ([]*hcloud.Server, error) {
+			return client.Server.AllWithOpts(ctx, hcloud.ServerListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		Get: func(ctx context.Context, id int64) (*hcloud.Server, error) {
+			server, _, err := client.Server.GetByID(ctx, id)
+			return server, err
+		},
+		ID:     func(o *hcloud.Server) int64 { return o.ID },
+		Name:   func(o *hcloud.Server) string { return o.Name },
+		Labels: func(o *hcloud.Server) map[string]string { return o.Labels },
+	}, opts)
+}
+
+// NewLoadBalancerInformer creates an [Informer] for the load balancers of the project.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewLoadBalancerInformer(client *hcloud.Client, opts Opts) (*Informer[hcloud.LoadBalancer], error) {
+	return New(Source[hcloud.LoadBalancer]{
+		Type: hcloud.ActionResourceTypeLoadBalancer,
+		List: func(ctx context.Context, labelSelector string) ([]*hcloud.LoadBalancer, error) {
+			return client.LoadBalancer.AllWithOpts(ctx, hcloud.LoadBalancerListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		Get: func(ctx context.Context, id int64) (*hcloud.LoadBalancer, error) {
+			loadBalancer, _, err := client.LoadBalancer.GetByID(ctx, id)
+			return loadBalancer, err
+		},
+		ID:     func(o *hcloud.LoadBalancer) int64 { return o.ID },
+		Name:   func(o *hcloud.LoadBalancer) string { return o.Name },
+		Labels: func(o *hcloud.LoadBalancer) map[string]string { return o.Labels },
+	}, opts)
+}
+
+// NewVolumeInformer creates an [Informer] for the volumes of the project.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewVolumeInformer(client *hcloud.Client, opts Opts) (*Informer[hcloud.Volume], error) {
+	return New(Source[hcloud.Volume]{
+		Type: hcloud.ActionResourceTypeVolume,
+		List: func(ctx context.Context, labelSelector string) ([]*hcloud.Volume, error) {
+			return client.Volume.AllWithOpts(ctx, hcloud.VolumeListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		Get: func(ctx context.Context, id int64) (*hcloud.Volume, error) {
+			volume, _, err := client.Volume.GetByID(ctx, id)
+			return volume, err
+		},
+		ID:     func(o *hcloud.Volume) int64 { return o.ID },
+		Name:   func(o *hcloud.Volume) string { return o.Name },
+		Labels: func(o *hcloud.Volume) map[string]string { return o.Labels },
+	}, opts)
+}
+
+// NewPrimaryIPInformer creates an [Informer] for the primary IPs of the project.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewPrimaryIPInformer(client *hcloud.Client, opts Opts) (*Informer[hcloud.PrimaryIP], error) {
+	return New(Source[hcloud.PrimaryIP]{
+		Type: hcloud.ActionResourceTypePrimaryIP,
+		List: func(ctx context.Context, labelSelector string) ([]*hcloud.PrimaryIP, error) {
+			return client.PrimaryIP.AllWithOpts(ctx, hcloud.PrimaryIPListOpts{ListOpts: hcloud.ListOpts{LabelSelector: labelSelector}})
+		},
+		Get: func(ctx context.Context, id int64) (*hcloud.PrimaryIP, error) {
+			primaryIP, _, err := client.PrimaryIP.GetByID(ctx, id)
+			return primaryIP, err
+		},
+		ID:     func(o *hcloud.PrimaryIP) int64 { return o.ID },
+		Name:   func(o *hcloud.PrimaryIP) string { return o.Name },
+		Labels: func(o *hcloud.PrimaryIP) map[string]string { return o.Labels },
+	}, opts)
+}
@@ -0,0 +1,165 @@
+package labelutil
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Matcher reports whether a label set is selected by a [label selector](https://docs.hetzner.cloud/reference/cloud#label-selector).
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Matcher struct {
+	requirements []requirement
+}
+
+type operator int
+
+const (
+	operatorEquals operator = iota
+	operatorNotEquals
+	operatorExists
+	operatorNotExists
+	operatorIn
+	operatorNotIn
+)
+
+type requirement struct {
+	key      string
+	operator operator
+	values   []string
+}
+
+func (r requirement) matches(labels map[string]string) bool {
+	value, ok := labels[r.key]
+
+	switch r.operator {
+	case operatorEquals:
+		return ok && value == r.values[0]
+	case operatorNotEquals:
+		return !ok || value != r.values[0]
+	case operatorExists:
+		return ok
+	case operatorNotExists:
+		return !ok
+	case operatorIn:
+		return ok && slices.Contains(r.values, value)
+	case operatorNotIn:
+		return !ok || !slices.Contains(r.values, value)
+	}
+	return false
+}
+
+// ParseSelector parses a [label selector](https://docs.hetzner.cloud/reference/cloud#label-selector), for example
+// "env=prod,tier in (web,api),!deprecated", into a [Matcher].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func ParseSelector(selector string) (*Matcher, error) {
+	m := &Matcher{}
+
+	for _, expr := range splitSelector(selector) {
+		expr = strings.TrimSpace(expr)
+		if expr == "" {
+			continue
+		}
+
+		r, err := parseRequirement(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector %q: %w", selector, err)
+		}
+		m.requirements = append(m.requirements, r)
+	}
+
+	return m, nil
+}
+
+// Matches returns whether the labels satisfy all the requirements of the selector. An
+// empty selector matches all label sets.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (m *Matcher) Matches(labels map[string]string) bool {
+	for _, r := range m.requirements {
+		if !r.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitSelector splits the selector on the commas that are not part of a set of values.
+func splitSelector(selector string) []string {
+	result := []string{}
+
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(result, selector[start:])
+}
+
+func parseRequirement(expr string) (requirement, error) {
+	if key, values, ok := cutSetOperator(expr, " notin "); ok {
+		return newSetRequirement(key, operatorNotIn, values)
+	}
+	if key, values, ok := cutSetOperator(expr, " in "); ok {
+		return newSetRequirement(key, operatorIn, values)
+	}
+	if key, value, ok := strings.Cut(expr, "!="); ok {
+		return newRequirement(key, operatorNotEquals, value)
+	}
+	if key, value, ok := strings.Cut(expr, "=="); ok {
+		return newRequirement(key, operatorEquals, value)
+	}
+	if key, value, ok := strings.Cut(expr, "="); ok {
+		return newRequirement(key, operatorEquals, value)
+	}
+	if key, ok := strings.CutPrefix(expr, "!"); ok {
+		return newRequirement(key, operatorNotExists)
+	}
+	return newRequirement(expr, operatorExists)
+}
+
+func cutSetOperator(expr, op string) (string, string, bool) {
+	key, values, ok := strings.Cut(expr, op)
+	if !ok {
+		return "", "", false
+	}
+	return key, strings.TrimSpace(values), true
+}
+
+func newSetRequirement(key string, op operator, values string) (requirement, error) {
+	inner, ok := strings.CutPrefix(values, "(")
+	if ok {
+		inner, ok = strings.CutSuffix(inner, ")")
+	}
+	if !ok {
+		return requirement{}, fmt.Errorf("values of %q must be enclosed in parentheses", strings.TrimSpace(key))
+	}
+
+	parts := strings.Split(inner, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return newRequirement(key, op, parts...)
+}
+
+func newRequirement(key string, op operator, values ...string) (requirement, error) {
+	key = strings.TrimSpace(key)
+	if key == "" || strings.ContainsAny(key, " !=()") {
+		return requirement{}, fmt.Errorf("invalid label key %q", key)
+	}
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return requirement{key: key, operator: op, values: values}, nil
+}
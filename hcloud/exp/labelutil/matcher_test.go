@@ -0,0 +1,55 @@
+package labelutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatcher(t *testing.T) {
+	labels := map[string]string{"env": "prod", "tier": "web"}
+
+	tests := []struct {
+		selector string
+		expected bool
+	}{
+		{selector: "", expected: true},
+		{selector: "env=prod", expected: true},
+		{selector: "env==prod", expected: true},
+		{selector: "env=dev", expected: false},
+		{selector: "env!=dev", expected: true},
+		{selector: "region!=eu", expected: true},
+		{selector: "env", expected: true},
+		{selector: "region", expected: false},
+		{selector: "!region", expected: true},
+		{selector: "!env", expected: false},
+		{selector: "tier in (web, api)", expected: true},
+		{selector: "tier in (db)", expected: false},
+		{selector: "tier notin (db,cache)", expected: true},
+		{selector: "region notin (eu)", expected: true},
+		{selector: "env=prod,tier in (web,api),!deprecated", expected: true},
+		{selector: "env=prod,tier in (db,api)", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			matcher, err := ParseSelector(tt.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, matcher.Matches(labels))
+		})
+	}
+}
+
+func TestParseSelectorInvalid(t *testing.T) {
+	for _, selector := range []string{
+		"=prod",
+		"tier in web",
+		"tier notin (web",
+		"my env=prod",
+	} {
+		t.Run(selector, func(t *testing.T) {
+			_, err := ParseSelector(selector)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,435 @@
+package mockutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/redact"
+)
+
+// RecorderMode defines whether a [Recorder] records or replays the interactions.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type RecorderMode int
+
+const (
+	// RecorderModeReplay replays the interactions of the cassette, without sending any
+	// request.
+	RecorderModeReplay RecorderMode = iota
+	// RecorderModeRecord sends the requests, and records the interactions to the
+	// cassette at the end of the test.
+	RecorderModeRecord
+)
+
+// documentationPrefixes are the IP ranges reserved for documentation, used to replace
+// the recorded IPs.
+var documentationPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// fakeIPv4Prefixes are the documentation ranges used to replace the public IPv4s, in
+// order of use.
+var fakeIPv4Prefixes = []netip.Prefix{
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+}
+
+// RecorderOpts defines the options used by [NewRecorder].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type RecorderOpts struct {
+	// Mode defines whether the interactions are recorded or replayed. Defaults to
+	// [RecorderModeReplay].
+	Mode RecorderMode
+	// Transport sends the requests in [RecorderModeRecord]. Defaults to
+	// [http.DefaultTransport].
+	Transport http.RoundTripper
+	// ScrubFields are the paths of the JSON fields to scrub from the request and
+	// response bodies, in addition to the sensitive fields known by the library (e.g.
+	// passwords, private keys). The paths use the format of
+	// [hcloud.WithRedactedFields].
+	ScrubFields []string
+}
+
+// Interaction is a request and its response recorded in a cassette.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request recorded in a cassette.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type RecordedRequest struct {
+	Method string          `json:"method"`
+	OpPath string          `json:"op_path"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response recorded in a cassette.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type RecordedResponse struct {
+	Status int                 `json:"status"`
+	Header map[string][]string `json:"header,omitempty"`
+	Body   json.RawMessage     `json:"body,omitempty"`
+	Text   string              `json:"text,omitempty"`
+}
+
+// Recorder is a [http.RoundTripper] that records the interactions with the API to a
+// cassette file, and replays them deterministically.
+//
+// The requests are matched on their method, their operation path (see
+// [ctxutil.OpPath]) and their normalized body, in the order of the cassette. When all
+// the matching interactions were replayed, the last one is replayed again, e.g. while
+// waiting for an action to complete.
+//
+// The authorization header is never recorded. The values of sensitive JSON fields are
+// replaced with "REDACTED", and the public IPs are consistently replaced with IPs of the
+// documentation ranges.
+//
+//	recorder := mockutil.NewRecorder(t, "testdata/create_server.json", mockutil.RecorderOpts{})
+//	client := hcloud.NewClient(
+//		hcloud.WithToken(token),
+//		hcloud.WithHTTPClient(recorder.Client()),
+//	)
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+type Recorder struct {
+	t    testing.TB
+	path string
+	opts RecorderOpts
+
+	mu           sync.Mutex
+	scrubber     *scrubber
+	interactions []Interaction
+	replayed     []bool
+}
+
+// NewRecorder returns a new [Recorder] for the cassette file. In
+// [RecorderModeReplay], the cassette is loaded immediately. In [RecorderModeRecord],
+// the cassette is written at the end of the test, benchmark or fuzz test.
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func NewRecorder(t testing.TB, path string, opts RecorderOpts) *Recorder {
+	t.Helper()
+
+	if opts.Transport == nil {
+		opts.Transport = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		t:        t,
+		path:     path,
+		opts:     opts,
+		scrubber: newScrubber(redact.New(redact.DefaultFields, opts.ScrubFields)),
+	}
+
+	switch opts.Mode {
+	case RecorderModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("could not read cassette: %v", err)
+		}
+		if err := json.Unmarshal(data, &r.interactions); err != nil {
+			t.Fatalf("could not parse cassette: %v", err)
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	case RecorderModeRecord:
+		t.Cleanup(r.save)
+	}
+
+	return r
+}
+
+// Client returns a [http.Client] using the [Recorder], to pass to
+// [hcloud.WithHTTPClient].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements [http.RoundTripper].
+//
+// Experimental: `exp` package is experimental, breaking changes may occur within minor releases.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	recorded := RecordedRequest{
+		Method: req.Method,
+		OpPath: ctxutil.OpPath(req.Context()),
+		URL:    r.scrubber.scrubURL(req.URL.RequestURI()),
+		Body:   r.scrubber.scrubBody(body),
+	}
+	if recorded.OpPath == "" {
+		recorded.OpPath = req.URL.Path
+	}
+	if err := r.scrubber.err; err != nil {
+		r.t.Error(err)
+		return nil, err
+	}
+
+	if r.opts.Mode == RecorderModeRecord {
+		return r.record(req, recorded)
+	}
+	return r.replay(req, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	resp, err := r.opts.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	response := RecordedResponse{Status: resp.StatusCode, Header: header}
+	if json.Valid(body) {
+		response.Body = r.scrubber.scrubBody(body)
+		if err := r.scrubber.err; err != nil {
+			r.t.Error(err)
+			return nil, err
+		}
+	} else {
+		response.Text = string(body)
+	}
+
+	r.interactions = append(r.interactions, Interaction{Request: recorded, Response: response})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	last := -1
+	for i, interaction := range r.interactions {
+		if !interaction.Request.matches(recorded) {
+			continue
+		}
+		last = i
+		if !r.replayed[i] {
+			break
+		}
+	}
+	if last < 0 {
+		r.t.Errorf("no recorded interaction for %s %s", recorded.Method, recorded.URL)
+		return nil, fmt.Errorf("no recorded interaction for %s %s", recorded.Method, recorded.URL)
+	}
+	r.replayed[last] = true
+
+	response := r.interactions[last].Response
+	body := []byte(response.Text)
+	if len(response.Body) > 0 {
+		body = response.Body
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.Status, http.StatusText(response.Status)),
+		StatusCode:    response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header(response.Header).Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) save() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.t.Failed() {
+		r.t.Log("test failed, not saving the cassette")
+		return
+	}
+
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		r.t.Errorf("could not encode cassette: %v", err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		r.t.Errorf("could not write cassette: %v", err)
+		return
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o600); err != nil {
+		r.t.Errorf("could not write cassette: %v", err)
+	}
+}
+
+func (o RecordedRequest) matches(other RecordedRequest) bool {
+	return o.Method == other.Method &&
+		o.OpPath == other.OpPath &&
+		bytes.Equal(normalizeJSON(o.Body), normalizeJSON(other.Body))
+}
+
+// normalizeJSON returns the JSON document with its keys sorted and without
+// insignificant whitespaces.
+func normalizeJSON(data []byte) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return data
+	}
+	result, err := json.Marshal(value)
+	if err != nil {
+		return data
+	}
+	return result
+}
+
+// scrubber removes the sensitive values from the recorded requests and responses.
+type scrubber struct {
+	redactor *redact.Redactor
+	ips      map[netip.Addr]netip.Addr
+	count4   int
+	count6   int
+	// err is set when the public IPs could not be replaced.
+	err error
+}
+
+func newScrubber(redactor *redact.Redactor) *scrubber {
+	return &scrubber{redactor: redactor, ips: make(map[netip.Addr]netip.Addr)}
+}
+
+// scrubBody returns the normalized JSON body with the sensitive values replaced. Bodies
+// that are not valid JSON are returned as is.
+func (s *scrubber) scrubBody(body []byte) []byte {
+	if len(body) == 0 {
+		return nil
+	}
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+	s.redactor.Value(value)
+	result, err := json.Marshal(s.scrubValue(value))
+	if err != nil {
+		return body
+	}
+	return result
+}
+
+func (s *scrubber) scrubValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = s.scrubValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = s.scrubValue(item)
+		}
+	case string:
+		return s.scrubIP(v)
+	}
+	return value
+}
+
+// scrubURL replaces the IPs in the query of the URL.
+func (s *scrubber) scrubURL(uri string) string {
+	path, query, ok := strings.Cut(uri, "?")
+	if !ok {
+		return uri
+	}
+	params := strings.Split(query, "&")
+	for i, param := range params {
+		if key, value, ok := strings.Cut(param, "="); ok {
+			params[i] = key + "=" + s.scrubIP(value)
+		}
+	}
+	return path + "?" + strings.Join(params, "&")
+}
+
+// scrubIP replaces the value when it is a public IP address or network, with an IP of
+// the documentation ranges. The same IP is always replaced with the same value.
+func (s *scrubber) scrubIP(value string) string {
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		return netip.PrefixFrom(s.fakeIP(prefix.Addr()), prefix.Bits()).String()
+	}
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return s.fakeIP(addr).String()
+	}
+	return value
+}
+
+func (s *scrubber) fakeIP(addr netip.Addr) netip.Addr {
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return addr
+	}
+	for _, prefix := range documentationPrefixes {
+		if prefix.Contains(addr) {
+			return addr
+		}
+	}
+
+	if fake, ok := s.ips[addr]; ok {
+		return fake
+	}
+
+	var fake netip.Addr
+	if addr.Is4() {
+		// The network and broadcast addresses of the ranges are not used.
+		i, host := s.count4/254, s.count4%254+1
+		if i >= len(fakeIPv4Prefixes) {
+			if s.err == nil {
+				s.err = fmt.Errorf("cannot replace more than %d distinct public IPv4 addresses", 254*len(fakeIPv4Prefixes))
+			}
+			return addr
+		}
+		s.count4++
+		ip := fakeIPv4Prefixes[i].Addr().As4()
+		ip[3] = byte(host)
+		fake = netip.AddrFrom4(ip)
+	} else {
+		s.count6++
+		fake = netip.AddrFrom16([16]byte{
+			0x20, 0x01, 0x0d, 0xb8,
+			byte(s.count6 >> 8), byte(s.count6), byte(s.count6 >> 24), byte(s.count6 >> 16),
+		})
+	}
+	s.ips[addr] = fake
+	return fake
+}
@@ -0,0 +1,156 @@
+package mockutil
+
+import (
+	"context"
+	"net/http"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/internal/redact"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	cassette := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+
+	createServer := func(t *testing.T, client *hcloud.Client) {
+		result, _, err := client.Server.Create(ctx, hcloud.ServerCreateOpts{
+			Name:       "web",
+			ServerType: &hcloud.ServerType{Name: "cpx22"},
+			Image:      &hcloud.Image{Name: "debian-13"},
+			Labels:     map[string]string{"env": "prod", "tier": "web"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "REDACTED", result.RootPassword)
+
+		require.NoError(t, client.Action.WaitFor(ctx, result.Action))
+
+		server, _, err := client.Server.GetByID(ctx, result.Server.ID)
+		require.NoError(t, err)
+		assert.Equal(t, hcloud.ServerStatusRunning, server.Status)
+		assert.Equal(t, "203.0.113.1", server.PublicNet.IPv4.IP.String())
+		assert.Equal(t, "10.0.0.2", server.PrivateNet[0].IP.String())
+	}
+
+	t.Run("record", func(t *testing.T) {
+		server := NewServer(t, []Request{
+			{
+				Method: "POST", Path: "/servers",
+				Want: func(t *testing.T, r *http.Request) {
+					assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				},
+				Status: 201,
+				JSONRaw: `{
+					"server": { "id": 1, "name": "web", "status": "initializing", "public_net": { "ipv4": { "ip": "95.216.1.2" }}},
+					"action": { "id": 10, "status": "running" },
+					"root_password": "secret-password"
+				}`,
+			},
+			{
+				Method: "GET", Path: "/actions?id=10&page=1&sort=status&sort=id",
+				Status:  200,
+				JSONRaw: `{ "actions": [{ "id": 10, "status": "running" }] }`,
+			},
+			{
+				Method: "GET", Path: "/actions?id=10&page=1&sort=status&sort=id",
+				Status:  200,
+				JSONRaw: `{ "actions": [{ "id": 10, "status": "success" }] }`,
+			},
+			{
+				Method: "GET", Path: "/servers/1",
+				Status: 200,
+				JSONRaw: `{
+					"server": {
+						"id": 1, "name": "web", "status": "running",
+						"public_net": { "ipv4": { "ip": "95.216.1.2" }},
+						"private_net": [{ "network": 1, "ip": "10.0.0.2" }]
+					}
+				}`,
+			},
+		})
+
+		recorder := NewRecorder(t, cassette, RecorderOpts{Mode: RecorderModeRecord})
+		client := hcloud.NewClient(
+			hcloud.WithToken("secret"),
+			hcloud.WithEndpoint(server.URL),
+			hcloud.WithHTTPClient(recorder.Client()),
+			hcloud.WithPollOpts(hcloud.PollOpts{BackoffFunc: hcloud.ConstantBackoff(0)}),
+		)
+
+		result, _, err := client.Server.Create(ctx, hcloud.ServerCreateOpts{
+			Name:       "web",
+			ServerType: &hcloud.ServerType{Name: "cpx22"},
+			Image:      &hcloud.Image{Name: "debian-13"},
+			Labels:     map[string]string{"tier": "web", "env": "prod"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "secret-password", result.RootPassword)
+
+		require.NoError(t, client.Action.WaitFor(ctx, result.Action))
+
+		_, _, err = client.Server.GetByID(ctx, result.Server.ID)
+		require.NoError(t, err)
+	})
+
+	data, err := os.ReadFile(cassette)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+	assert.NotContains(t, string(data), "95.216.1.2")
+	assert.Contains(t, string(data), `"op_path": "/servers/-"`)
+
+	t.Run("replay", func(t *testing.T) {
+		recorder := NewRecorder(t, cassette, RecorderOpts{})
+		client := hcloud.NewClient(
+			hcloud.WithToken("other"),
+			hcloud.WithEndpoint("http://127.0.0.1:0"),
+			hcloud.WithHTTPClient(recorder.Client()),
+			hcloud.WithPollOpts(hcloud.PollOpts{BackoffFunc: hcloud.ConstantBackoff(0)}),
+		)
+
+		createServer(t, client)
+	})
+}
+
+func TestScrubber(t *testing.T) {
+	s := newScrubber(redact.New(redact.DefaultFields))
+
+	assert.JSONEq(t,
+		`{
+			"password": "REDACTED",
+			"ips": ["203.0.113.1", "2001:db8:1::/64", "203.0.113.1", "10.0.0.1", "0.0.0.0/0", "198.51.100.3"],
+			"primary_nameservers": [{ "tsig_key": "REDACTED", "name": "95.216.1.2.example.com" }],
+			"storage_box": { "password": "not-sensitive" }
+		}`,
+		string(s.scrubBody([]byte(`{
+			"password": "secret",
+			"ips": ["95.216.1.2", "2a01:4f8:1:2::/64", "95.216.1.2", "10.0.0.1", "0.0.0.0/0", "198.51.100.3"],
+			"primary_nameservers": [{ "tsig_key": "secret", "name": "95.216.1.2.example.com" }],
+			"storage_box": { "password": "not-sensitive" }
+		}`))),
+	)
+
+	assert.Equal(t, "/primary_ips?ip=203.0.113.1&page=1", s.scrubURL("/primary_ips?ip=95.216.1.2&page=1"))
+
+	t.Run("distinct ips", func(t *testing.T) {
+		s := newScrubber(redact.New())
+
+		fakes := make(map[netip.Addr]struct{})
+		for i := range 254 * 3 {
+			fake := s.fakeIP(netip.AddrFrom4([4]byte{95, 216, byte(i >> 8), byte(i)}))
+			assert.True(t, slices.ContainsFunc(fakeIPv4Prefixes, func(p netip.Prefix) bool { return p.Contains(fake) }))
+			fakes[fake] = struct{}{}
+		}
+		assert.Len(t, fakes, 254*3)
+		require.NoError(t, s.err)
+
+		s.fakeIP(netip.MustParseAddr("95.217.0.1"))
+		require.EqualError(t, s.err, "cannot replace more than 762 distinct public IPv4 addresses")
+	})
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"strconv"
@@ -179,7 +180,20 @@ func (c *FirewallClient) AllWithOpts(ctx context.Context, opts FirewallListOpts)
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Firewall, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Firewall, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all Firewalls for the given options.
+// The pages are fetched lazily while iterating.
+func (c *FirewallClient) Iter(ctx context.Context, opts FirewallListOpts) iter.Seq2[*Firewall, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Firewall, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
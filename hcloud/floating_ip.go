@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"strconv"
@@ -173,7 +174,20 @@ func (c *FloatingIPClient) AllWithOpts(ctx context.Context, opts FloatingIPListO
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*FloatingIP, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*FloatingIP, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all Floating IPs for the given options.
+// The pages are fetched lazily while iterating.
+func (c *FloatingIPClient) Iter(ctx context.Context, opts FloatingIPListOpts) iter.Seq2[*FloatingIP, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*FloatingIP, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -9,7 +9,7 @@ tool() {
     )
 }
 
-tool github.com/vburenin/ifacemaker -f action.go -f action_watch.go -f action_waiter.go -s ActionClient -i IActionClient -p hcloud -o zz_action_client_iface.go
+tool github.com/vburenin/ifacemaker -f action.go -f action_watch.go -f action_waiter.go -f action_query.go -f action_stream.go -s ActionClient -i IActionClient -p hcloud -o zz_action_client_iface.go
 tool github.com/vburenin/ifacemaker -f action.go -s ResourceActionClient -i IResourceActionClient -p hcloud -o zz_resource_action_client_iface.go
 tool github.com/vburenin/ifacemaker -f datacenter.go -s DatacenterClient -i IDatacenterClient -p hcloud -o zz_datacenter_client_iface.go
 tool github.com/vburenin/ifacemaker -f floating_ip.go -s FloatingIPClient -i IFloatingIPClient -p hcloud -o zz_floating_ip_client_iface.go
@@ -33,5 +33,36 @@ tool github.com/vburenin/ifacemaker -f zone.go -f zone_rrset.go -s ZoneClient -i
 tool github.com/vburenin/ifacemaker -f storage_box_type.go -s StorageBoxTypeClient -i IStorageBoxTypeClient -p hcloud -o zz_storage_box_type_client_iface.go
 tool github.com/vburenin/ifacemaker -f storage_box.go -f storage_box_snapshot.go -f storage_box_subaccount.go -s StorageBoxClient -i IStorageBoxClient -p hcloud -o zz_storage_box_client_iface.go
 
+# Mock implementations of the client interfaces, in the mock package.
+mock() {
+    tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i "I$1" -t mock.tmpl -o "mock/zz_$2.go" -v "Name=$1"
+    # Use the regular name of the source package.
+    sed -i.bak -e 's/_sourceHcloud "/"/' -e 's/_sourceHcloud\./hcloud./g' "mock/zz_$2.go"
+    rm "mock/zz_$2.go.bak"
+}
+
+mock ActionClient action_client
+mock CertificateClient certificate_client
+mock DatacenterClient datacenter_client
+mock FirewallClient firewall_client
+mock FloatingIPClient floating_ip_client
+mock ImageClient image_client
+mock ISOClient iso_client
+mock LoadBalancerClient load_balancer_client
+mock LoadBalancerTypeClient load_balancer_type_client
+mock LocationClient location_client
+mock NetworkClient network_client
+mock PlacementGroupClient placement_group_client
+mock PricingClient pricing_client
+mock PrimaryIPClient primary_ip_client
+mock RDNSClient rdns_client
+mock ServerClient server_client
+mock ServerTypeClient server_type_client
+mock SSHKeyClient ssh_key_client
+mock StorageBoxClient storage_box_client
+mock StorageBoxTypeClient storage_box_type_client
+mock VolumeClient volume_client
+mock ZoneClient zone_client
+
 tool github.com/hexdigest/gowrap/cmd/gowrap gen -g -p . -i converter -t schema.tmpl -o zz_schema.go
 tool github.com/jmattheis/goverter/cmd/goverter gen ./...
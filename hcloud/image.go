@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -213,7 +214,20 @@ func (c *ImageClient) AllWithOpts(ctx context.Context, opts ImageListOpts) ([]*I
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Image, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Image, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all images for the given options.
+// The pages are fetched lazily while iterating.
+func (c *ImageClient) Iter(ctx context.Context, opts ImageListOpts) iter.Seq2[*Image, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Image, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -284,3 +298,21 @@ func (c *ImageClient) ChangeProtection(ctx context.Context, image *Image, opts I
 
 	return ActionFromSchema(respBody.Action), resp, nil
 }
+
+// WaitUntil fetches the image at the interval defined by [WithPollOpts], until the
+// condition holds (e.g. [ImageIsAvailable]), and returns the latest state of the image.
+//
+// The function stops waiting when the condition returns an error, when the image does
+// not exist, or when the context is done.
+func (c *ImageClient) WaitUntil(ctx context.Context, image *Image, condition func(*Image) (bool, error)) (*Image, error) {
+	return waitUntil(ctx, c.client, fmt.Sprintf("image %d", image.ID),
+		func(ctx context.Context) (*Image, *Response, error) { return c.GetByID(ctx, image.ID) },
+		condition,
+	)
+}
+
+// ImageIsAvailable is a [ImageClient.WaitUntil] condition which holds when the image is
+// available.
+func ImageIsAvailable(image *Image) (bool, error) {
+	return image.Status == ImageStatusAvailable, nil
+}
@@ -24,6 +24,15 @@ func New(subsystemIdentifier string, instrumentationRegistry prometheus.Register
 	return &Instrumenter{subsystemIdentifier: subsystemIdentifier, instrumentationRegistry: instrumentationRegistry}
 }
 
+// WithProject returns a copy of the Instrumenter that adds the project label to all its
+// metrics.
+func (i *Instrumenter) WithProject(project string) *Instrumenter {
+	return &Instrumenter{
+		subsystemIdentifier:     i.subsystemIdentifier,
+		instrumentationRegistry: prometheus.WrapRegistererWith(prometheus.Labels{"project": project}, i.instrumentationRegistry),
+	}
+}
+
 // InstrumentedRoundTripper returns an instrumented round tripper.
 func (i *Instrumenter) InstrumentedRoundTripper(transport http.RoundTripper) http.RoundTripper {
 	// By default, http client would use DefaultTransport on nil, but we internally are relying on it being configured
@@ -81,16 +90,10 @@ func (i *Instrumenter) instrumentRoundTripperEndpoint(counter *prometheus.Counte
 	return func(r *http.Request) (*http.Response, error) {
 		resp, err := next.RoundTrip(r)
 		if err == nil {
-			apiEndpoint := ctxutil.OpPath(r.Context())
-			// If the request does not set the operation path, we must construct it. Happens e.g. for
-			// user crafted requests.
-			if apiEndpoint == "" {
-				apiEndpoint = preparePathForLabel(resp.Request.URL.Path)
-			}
 			counter.WithLabelValues(
 				strconv.Itoa(resp.StatusCode),
 				strings.ToLower(resp.Request.Method),
-				apiEndpoint,
+				apiEndpointLabel(resp.Request),
 			).Inc()
 		}
 
@@ -98,6 +101,101 @@ func (i *Instrumenter) instrumentRoundTripperEndpoint(counter *prometheus.Counte
 	}
 }
 
+// RetryMetrics holds the metrics about the retried requests.
+type RetryMetrics struct {
+	retriesCounter          *prometheus.CounterVec
+	retriesExhaustedCounter *prometheus.CounterVec
+}
+
+// RetryMetrics returns the metrics about the retried requests.
+func (i *Instrumenter) RetryMetrics() *RetryMetrics {
+	return &RetryMetrics{
+		retriesCounter: registerOrReuse(
+			i.instrumentationRegistry,
+			prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: fmt.Sprintf("hcloud_%s_retries_total", i.subsystemIdentifier),
+					Help: fmt.Sprintf("A counter for retried requests to the hcloud %s per endpoint and reason.", i.subsystemIdentifier),
+				},
+				[]string{"api_endpoint", "reason"},
+			),
+		),
+		retriesExhaustedCounter: registerOrReuse(
+			i.instrumentationRegistry,
+			prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: fmt.Sprintf("hcloud_%s_retries_exhausted_total", i.subsystemIdentifier),
+					Help: fmt.Sprintf("A counter for requests to the hcloud %s that exhausted their retries per endpoint.", i.subsystemIdentifier),
+				},
+				[]string{"api_endpoint"},
+			),
+		),
+	}
+}
+
+// ObserveRetry counts a retry of the request for the given reason.
+func (m *RetryMetrics) ObserveRetry(r *http.Request, reason string) {
+	m.retriesCounter.WithLabelValues(apiEndpointLabel(r), reason).Inc()
+}
+
+// ObserveRetriesExhausted counts a request that exhausted its retries.
+func (m *RetryMetrics) ObserveRetriesExhausted(r *http.Request) {
+	m.retriesExhaustedCounter.WithLabelValues(apiEndpointLabel(r)).Inc()
+}
+
+// CircuitBreakerMetrics holds the metrics about the circuit breaker.
+type CircuitBreakerMetrics struct {
+	stateGauge      *prometheus.GaugeVec
+	rejectedCounter *prometheus.CounterVec
+}
+
+// CircuitBreakerMetrics returns the metrics about the circuit breaker.
+func (i *Instrumenter) CircuitBreakerMetrics() *CircuitBreakerMetrics {
+	return &CircuitBreakerMetrics{
+		stateGauge: registerOrReuse(
+			i.instrumentationRegistry,
+			prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: fmt.Sprintf("hcloud_%s_circuit_breaker_state", i.subsystemIdentifier),
+					Help: fmt.Sprintf("A gauge of the circuit breaker state (0=closed, 1=half-open, 2=open) for the hcloud %s per endpoint.", i.subsystemIdentifier),
+				},
+				[]string{"api_endpoint"},
+			),
+		),
+		rejectedCounter: registerOrReuse(
+			i.instrumentationRegistry,
+			prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: fmt.Sprintf("hcloud_%s_circuit_breaker_rejected_total", i.subsystemIdentifier),
+					Help: fmt.Sprintf("A counter for requests to the hcloud %s rejected by the circuit breaker per endpoint.", i.subsystemIdentifier),
+				},
+				[]string{"api_endpoint"},
+			),
+		),
+	}
+}
+
+// ObserveState sets the circuit breaker state of the request endpoint.
+func (m *CircuitBreakerMetrics) ObserveState(r *http.Request, state int) {
+	m.stateGauge.WithLabelValues(apiEndpointLabel(r)).Set(float64(state))
+}
+
+// ObserveRejected counts a request rejected by the circuit breaker.
+func (m *CircuitBreakerMetrics) ObserveRejected(r *http.Request) {
+	m.rejectedCounter.WithLabelValues(apiEndpointLabel(r)).Inc()
+}
+
+// apiEndpointLabel returns the API endpoint label of the request.
+func apiEndpointLabel(r *http.Request) string {
+	apiEndpoint := ctxutil.OpPath(r.Context())
+	// If the request does not set the operation path, we must construct it. Happens e.g. for
+	// user crafted requests.
+	if apiEndpoint == "" {
+		apiEndpoint = preparePathForLabel(r.URL.Path)
+	}
+	return apiEndpoint
+}
+
 // registerOrReuse will try to register the passed Collector, but in case a conflicting collector was already registered,
 // it will instead return that collector. Make sure to always use the collector return by this method.
 // Similar to [Registry.MustRegister] it will panic if any other error occurs.
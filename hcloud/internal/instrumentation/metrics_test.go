@@ -2,10 +2,12 @@ package instrumentation
 
 import (
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMultipleInstrumentedClients(t *testing.T) {
@@ -38,3 +40,21 @@ func TestPreparePathForLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestWithProject(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	New("test", reg).WithProject("a").RetryMetrics()
+	New("test", reg).WithProject("b").RetryMetrics().ObserveRetry(&http.Request{URL: &url.URL{Path: "/v1/servers"}}, "conflict")
+
+	metrics, err := reg.Gather()
+	require.NoError(t, err)
+	require.Len(t, metrics, 1)
+	require.Len(t, metrics[0].GetMetric(), 1)
+
+	labels := map[string]string{}
+	for _, label := range metrics[0].GetMetric()[0].GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	assert.Equal(t, map[string]string{"api_endpoint": "/servers", "project": "b", "reason": "conflict"}, labels)
+}
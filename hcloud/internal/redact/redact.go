@@ -0,0 +1,118 @@
+// Package redact replaces the values of sensitive fields in the JSON bodies sent or
+// received by the API.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// DefaultFields holds the paths of the sensitive JSON fields sent or received
+// by the API.
+var DefaultFields = []string{
+	// Server create, rebuild, enable rescue and reset password responses
+	"root_password",
+	// Server request console response, Storage Box and Storage Box Subaccount create and
+	// reset password requests
+	"password",
+	// Certificate create request
+	"private_key",
+	// Zone create and change primary nameservers requests
+	"primary_nameservers.tsig_key",
+	// Zone responses
+	"*.primary_nameservers.tsig_key",
+}
+
+// Redactor replaces the values of sensitive fields in JSON bodies.
+type Redactor struct {
+	paths [][]string
+}
+
+// New returns a [Redactor] for the given groups of field paths.
+//
+// A field is described by a dot separated path from the root of the JSON body (e.g.
+// "server.labels.secret"). The "*" path segment matches any field, and arrays are
+// traversed transparently (e.g. "zones.primary_nameservers.tsig_key").
+func New(paths ...[]string) *Redactor {
+	r := &Redactor{}
+	for _, group := range paths {
+		for _, path := range group {
+			r.paths = append(r.paths, strings.Split(path, "."))
+		}
+	}
+	return r
+}
+
+// Body returns the JSON body with the values of sensitive fields replaced. Bodies that
+// are not valid JSON, or without sensitive fields, are returned as is.
+func (r *Redactor) Body(body []byte) []byte {
+	var data any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return body
+	}
+
+	if !r.Value(data) {
+		return body
+	}
+
+	result, err := json.Marshal(data)
+	if err != nil {
+		return body
+	}
+	return result
+}
+
+// Value replaces the values of sensitive fields of the decoded JSON value in place, and
+// returns whether any value was replaced.
+func (r *Redactor) Value(data any) bool {
+	return r.redactValue(data, nil)
+}
+
+func (r *Redactor) redactValue(data any, path []string) bool {
+	redacted := false
+
+	switch value := data.(type) {
+	case map[string]any:
+		for key, item := range value {
+			itemPath := append(path[:len(path):len(path)], key)
+			if item != nil && r.match(itemPath) {
+				value[key] = "REDACTED"
+				redacted = true
+			} else if r.redactValue(item, itemPath) {
+				redacted = true
+			}
+		}
+	case []any:
+		for _, item := range value {
+			if r.redactValue(item, path) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+// match returns whether the path matches one of the sensitive field paths.
+func (r *Redactor) match(path []string) bool {
+	for _, pattern := range r.paths {
+		if len(pattern) != len(path) {
+			continue
+		}
+
+		matched := true
+		for i, segment := range pattern {
+			if segment != "*" && segment != path[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
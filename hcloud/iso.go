@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -40,6 +41,10 @@ type ISOClient struct {
 
 // GetByID retrieves an ISO by its ID.
 func (c *ISOClient) GetByID(ctx context.Context, id int64) (*ISO, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.isos.find(ctx, func(i *ISO) bool { return i.ID == id })
+	}
+
 	const opPath = "/isos/%d"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
@@ -58,6 +63,10 @@ func (c *ISOClient) GetByID(ctx context.Context, id int64) (*ISO, *Response, err
 
 // GetByName retrieves an ISO by its name.
 func (c *ISOClient) GetByName(ctx context.Context, name string) (*ISO, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil && name != "" {
+		return cache.isos.find(ctx, func(i *ISO) bool { return i.Name == name })
+	}
+
 	return firstByName(name, func() ([]*ISO, *Response, error) {
 		return c.List(ctx, ISOListOpts{Name: name})
 	})
@@ -123,6 +132,10 @@ func (c *ISOClient) List(ctx context.Context, opts ISOListOpts) ([]*ISO, *Respon
 
 // All returns all ISOs.
 func (c *ISOClient) All(ctx context.Context) ([]*ISO, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.isos.all(ctx)
+	}
+
 	return c.AllWithOpts(ctx, ISOListOpts{})
 }
 
@@ -131,7 +144,20 @@ func (c *ISOClient) AllWithOpts(ctx context.Context, opts ISOListOpts) ([]*ISO,
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*ISO, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*ISO, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all ISOs for the given options.
+// The pages are fetched lazily while iterating.
+func (c *ISOClient) Iter(ctx context.Context, opts ISOListOpts) iter.Seq2[*ISO, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*ISO, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"slices"
@@ -332,7 +333,20 @@ func (c *LoadBalancerClient) AllWithOpts(ctx context.Context, opts LoadBalancerL
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*LoadBalancer, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*LoadBalancer, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all Load Balancers for the given options.
+// The pages are fetched lazily while iterating.
+func (c *LoadBalancerClient) Iter(ctx context.Context, opts LoadBalancerListOpts) iter.Seq2[*LoadBalancer, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*LoadBalancer, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 
@@ -34,6 +35,10 @@ type LoadBalancerTypeClient struct {
 
 // GetByID retrieves a Load Balancer type by its ID. If the Load Balancer type does not exist, nil is returned.
 func (c *LoadBalancerTypeClient) GetByID(ctx context.Context, id int64) (*LoadBalancerType, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.loadBalancerTypes.find(ctx, func(l *LoadBalancerType) bool { return l.ID == id })
+	}
+
 	const opPath = "/load_balancer_types/%d"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
@@ -52,6 +57,10 @@ func (c *LoadBalancerTypeClient) GetByID(ctx context.Context, id int64) (*LoadBa
 
 // GetByName retrieves a Load Balancer type by its name. If the Load Balancer type does not exist, nil is returned.
 func (c *LoadBalancerTypeClient) GetByName(ctx context.Context, name string) (*LoadBalancerType, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil && name != "" {
+		return cache.loadBalancerTypes.find(ctx, func(l *LoadBalancerType) bool { return l.Name == name })
+	}
+
 	return firstByName(name, func() ([]*LoadBalancerType, *Response, error) {
 		return c.List(ctx, LoadBalancerTypeListOpts{Name: name})
 	})
@@ -104,6 +113,10 @@ func (c *LoadBalancerTypeClient) List(ctx context.Context, opts LoadBalancerType
 
 // All returns all Load Balancer types.
 func (c *LoadBalancerTypeClient) All(ctx context.Context) ([]*LoadBalancerType, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.loadBalancerTypes.all(ctx)
+	}
+
 	return c.AllWithOpts(ctx, LoadBalancerTypeListOpts{})
 }
 
@@ -112,7 +125,20 @@ func (c *LoadBalancerTypeClient) AllWithOpts(ctx context.Context, opts LoadBalan
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*LoadBalancerType, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*LoadBalancerType, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all Load Balancer types for the given options.
+// The pages are fetched lazily while iterating.
+func (c *LoadBalancerTypeClient) Iter(ctx context.Context, opts LoadBalancerTypeListOpts) iter.Seq2[*LoadBalancerType, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*LoadBalancerType, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
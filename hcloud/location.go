@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 
@@ -29,6 +30,10 @@ type LocationClient struct {
 
 // GetByID retrieves a location by its ID. If the location does not exist, nil is returned.
 func (c *LocationClient) GetByID(ctx context.Context, id int64) (*Location, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.locations.find(ctx, func(l *Location) bool { return l.ID == id })
+	}
+
 	const opPath = "/locations/%d"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
@@ -47,6 +52,10 @@ func (c *LocationClient) GetByID(ctx context.Context, id int64) (*Location, *Res
 
 // GetByName retrieves an location by its name. If the location does not exist, nil is returned.
 func (c *LocationClient) GetByName(ctx context.Context, name string) (*Location, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil && name != "" {
+		return cache.locations.find(ctx, func(l *Location) bool { return l.Name == name })
+	}
+
 	return firstByName(name, func() ([]*Location, *Response, error) {
 		return c.List(ctx, LocationListOpts{Name: name})
 	})
@@ -99,6 +108,10 @@ func (c *LocationClient) List(ctx context.Context, opts LocationListOpts) ([]*Lo
 
 // All returns all locations.
 func (c *LocationClient) All(ctx context.Context) ([]*Location, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.locations.all(ctx)
+	}
+
 	return c.AllWithOpts(ctx, LocationListOpts{})
 }
 
@@ -107,7 +120,20 @@ func (c *LocationClient) AllWithOpts(ctx context.Context, opts LocationListOpts)
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Location, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Location, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all locations for the given options.
+// The pages are fetched lazily while iterating.
+func (c *LocationClient) Iter(ctx context.Context, opts LocationListOpts) iter.Seq2[*Location, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Location, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
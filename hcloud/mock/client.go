@@ -0,0 +1,137 @@
+package mock
+
+import (
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// Client is a mock implementation of [hcloud.IClient], which returns the mock resource
+// clients of its fields.
+//
+// A Client must be created using the [NewClient] function.
+type Client struct {
+	Action           *ActionClient
+	Certificate      *CertificateClient
+	Firewall         *FirewallClient
+	FloatingIP       *FloatingIPClient
+	Image            *ImageClient
+	ISO              *ISOClient
+	LoadBalancer     *LoadBalancerClient
+	LoadBalancerType *LoadBalancerTypeClient
+	Location         *LocationClient
+	Network          *NetworkClient
+	Pricing          *PricingClient
+	Server           *ServerClient
+	ServerType       *ServerTypeClient
+	StorageBox       *StorageBoxClient
+	SSHKey           *SSHKeyClient
+	Volume           *VolumeClient
+	PlacementGroup   *PlacementGroupClient
+	RDNS             *RDNSClient
+	PrimaryIP        *PrimaryIPClient
+	StorageBoxType   *StorageBoxTypeClient
+	Zone             *ZoneClient
+
+	// Deprecated: [hcloud.DatacenterClient] is deprecated and will be removed after the 2026-10-01. See
+	// https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
+	Datacenter *DatacenterClient
+}
+
+var _ hcloud.IClient = (*Client)(nil)
+
+// NewClient returns a new [Client] with all its mock resource clients, whose
+// expectations are asserted when the test ends.
+func NewClient(t TestingT) *Client {
+	return &Client{
+		Action:           NewActionClient(t),
+		Certificate:      NewCertificateClient(t),
+		Firewall:         NewFirewallClient(t),
+		FloatingIP:       NewFloatingIPClient(t),
+		Image:            NewImageClient(t),
+		ISO:              NewISOClient(t),
+		LoadBalancer:     NewLoadBalancerClient(t),
+		LoadBalancerType: NewLoadBalancerTypeClient(t),
+		Location:         NewLocationClient(t),
+		Network:          NewNetworkClient(t),
+		Pricing:          NewPricingClient(t),
+		Server:           NewServerClient(t),
+		ServerType:       NewServerTypeClient(t),
+		StorageBox:       NewStorageBoxClient(t),
+		SSHKey:           NewSSHKeyClient(t),
+		Volume:           NewVolumeClient(t),
+		PlacementGroup:   NewPlacementGroupClient(t),
+		RDNS:             NewRDNSClient(t),
+		PrimaryIP:        NewPrimaryIPClient(t),
+		StorageBoxType:   NewStorageBoxTypeClient(t),
+		Zone:             NewZoneClient(t),
+		Datacenter:       NewDatacenterClient(t),
+	}
+}
+
+// ActionClient returns [Client.Action].
+func (c *Client) ActionClient() hcloud.IActionClient { return c.Action }
+
+// CertificateClient returns [Client.Certificate].
+func (c *Client) CertificateClient() hcloud.ICertificateClient { return c.Certificate }
+
+// FirewallClient returns [Client.Firewall].
+func (c *Client) FirewallClient() hcloud.IFirewallClient { return c.Firewall }
+
+// FloatingIPClient returns [Client.FloatingIP].
+func (c *Client) FloatingIPClient() hcloud.IFloatingIPClient { return c.FloatingIP }
+
+// ImageClient returns [Client.Image].
+func (c *Client) ImageClient() hcloud.IImageClient { return c.Image }
+
+// ISOClient returns [Client.ISO].
+func (c *Client) ISOClient() hcloud.IISOClient { return c.ISO }
+
+// LoadBalancerClient returns [Client.LoadBalancer].
+func (c *Client) LoadBalancerClient() hcloud.ILoadBalancerClient { return c.LoadBalancer }
+
+// LoadBalancerTypeClient returns [Client.LoadBalancerType].
+func (c *Client) LoadBalancerTypeClient() hcloud.ILoadBalancerTypeClient { return c.LoadBalancerType }
+
+// LocationClient returns [Client.Location].
+func (c *Client) LocationClient() hcloud.ILocationClient { return c.Location }
+
+// NetworkClient returns [Client.Network].
+func (c *Client) NetworkClient() hcloud.INetworkClient { return c.Network }
+
+// PricingClient returns [Client.Pricing].
+func (c *Client) PricingClient() hcloud.IPricingClient { return c.Pricing }
+
+// ServerClient returns [Client.Server].
+func (c *Client) ServerClient() hcloud.IServerClient { return c.Server }
+
+// ServerTypeClient returns [Client.ServerType].
+func (c *Client) ServerTypeClient() hcloud.IServerTypeClient { return c.ServerType }
+
+// StorageBoxClient returns [Client.StorageBox].
+func (c *Client) StorageBoxClient() hcloud.IStorageBoxClient { return c.StorageBox }
+
+// SSHKeyClient returns [Client.SSHKey].
+func (c *Client) SSHKeyClient() hcloud.ISSHKeyClient { return c.SSHKey }
+
+// VolumeClient returns [Client.Volume].
+func (c *Client) VolumeClient() hcloud.IVolumeClient { return c.Volume }
+
+// PlacementGroupClient returns [Client.PlacementGroup].
+func (c *Client) PlacementGroupClient() hcloud.IPlacementGroupClient { return c.PlacementGroup }
+
+// RDNSClient returns [Client.RDNS].
+func (c *Client) RDNSClient() hcloud.IRDNSClient { return c.RDNS }
+
+// PrimaryIPClient returns [Client.PrimaryIP].
+func (c *Client) PrimaryIPClient() hcloud.IPrimaryIPClient { return c.PrimaryIP }
+
+// StorageBoxTypeClient returns [Client.StorageBoxType].
+func (c *Client) StorageBoxTypeClient() hcloud.IStorageBoxTypeClient { return c.StorageBoxType }
+
+// ZoneClient returns [Client.Zone].
+func (c *Client) ZoneClient() hcloud.IZoneClient { return c.Zone }
+
+// DatacenterClient returns [Client.Datacenter].
+//
+// Deprecated: [hcloud.DatacenterClient] is deprecated and will be removed after the 2026-10-01. See
+// https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
+func (c *Client) DatacenterClient() hcloud.IDatacenterClient { return c.Datacenter }
@@ -0,0 +1,24 @@
+// Package mock provides mock implementations of the resource client interfaces of the
+// hcloud package (e.g. [hcloud.IServerClient]), and of [hcloud.IClient].
+//
+// The mocks are based on [mock.Mock], the expectations of the mocks created by the
+// constructors are asserted when the test ends:
+//
+//	client := mock.NewClient(t)
+//	client.Server.
+//		On("GetByID", ctx, int64(1)).
+//		Return(&hcloud.Server{ID: 1, Name: "web"}, nil, nil).
+//		Once()
+//
+//	provision(ctx, client)
+package mock
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+// TestingT is the interface of [testing.T] used by the mocks.
+type TestingT interface {
+	mock.TestingT
+	Cleanup(func())
+}
@@ -0,0 +1,74 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// provision is an example of code depending on the [hcloud.IClient] interface.
+func provision(ctx context.Context, client hcloud.IClient, name string) (*hcloud.Server, error) {
+	server, _, err := client.ServerClient().GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if server != nil {
+		return server, nil
+	}
+
+	result, _, err := client.ServerClient().Create(ctx, hcloud.ServerCreateOpts{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ActionClient().WaitFor(ctx, result.Action); err != nil {
+		return nil, err
+	}
+	return result.Server, nil
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	client := NewClient(t)
+	client.Server.
+		On("GetByName", ctx, "web").
+		Return(nil, nil, nil).
+		Once()
+	client.Server.
+		On("Create", ctx, hcloud.ServerCreateOpts{Name: "web"}).
+		Return(hcloud.ServerCreateResult{
+			Server: &hcloud.Server{ID: 1, Name: "web"},
+			Action: &hcloud.Action{ID: 10},
+		}, nil, nil).
+		Once()
+	client.Action.
+		On("WaitFor", ctx, []*hcloud.Action{{ID: 10}}).
+		Return(nil).
+		Once()
+
+	server, err := provision(ctx, client, "web")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), server.ID)
+
+	client.Server.
+		On("GetByName", ctx, "web").
+		Return(nil, nil, errors.New("failure")).
+		Once()
+
+	_, err = provision(ctx, client, "web")
+	require.EqualError(t, err, "failure")
+	client.Server.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestUnexpectedCall(t *testing.T) {
+	client := &ZoneClient{}
+
+	assert.Panics(t, func() {
+		_, _, _ = client.GetByID(context.Background(), 1)
+	})
+}
@@ -0,0 +1,165 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// ActionClient is a mock implementation of [hcloud.IActionClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type ActionClient struct {
+	mock.Mock
+}
+
+// NewActionClient returns a new [ActionClient], whose expectations are asserted when the
+// test ends.
+func NewActionClient(t TestingT) *ActionClient {
+	m := &ActionClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IActionClient].
+func (m *ActionClient) All(ctx context.Context) (actions []*hcloud.Action, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		actions = value.([]*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IActionClient].
+func (m *ActionClient) AllWithOpts(ctx context.Context, opts hcloud.ActionListOpts) (actions []*hcloud.Action, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		actions = value.([]*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IActionClient].
+func (m *ActionClient) GetByID(ctx context.Context, id int64) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IActionClient].
+func (m *ActionClient) Iter(ctx context.Context, opts hcloud.ActionListOpts) (actions iter.Seq2[*hcloud.Action, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		actions = value.(iter.Seq2[*hcloud.Action, error])
+	}
+	return
+}
+
+// List implements [hcloud.IActionClient].
+func (m *ActionClient) List(ctx context.Context, opts hcloud.ActionListOpts) (actions []*hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		actions = value.([]*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Query implements [hcloud.IActionClient].
+func (m *ActionClient) Query(ctx context.Context, query hcloud.ActionQuery) (actions iter.Seq2[*hcloud.Action, error]) {
+	args := m.Called(ctx, query)
+	if value := args.Get(0); value != nil {
+		actions = value.(iter.Seq2[*hcloud.Action, error])
+	}
+	return
+}
+
+// Updates implements [hcloud.IActionClient].
+func (m *ActionClient) Updates(ctx context.Context, actions ...*hcloud.Action) (actionUpdates iter.Seq2[hcloud.ActionUpdate, error]) {
+	args := m.Called(ctx, actions)
+	if value := args.Get(0); value != nil {
+		actionUpdates = value.(iter.Seq2[hcloud.ActionUpdate, error])
+	}
+	return
+}
+
+// WaitFor implements [hcloud.IActionClient].
+func (m *ActionClient) WaitFor(ctx context.Context, actions ...*hcloud.Action) (err error) {
+	args := m.Called(ctx, actions)
+	if value := args.Get(0); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// WaitForFunc implements [hcloud.IActionClient].
+func (m *ActionClient) WaitForFunc(ctx context.Context, handleUpdate func(update *hcloud.Action) error, actions ...*hcloud.Action) (err error) {
+	args := m.Called(ctx, handleUpdate, actions)
+	if value := args.Get(0); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// WatchOverallProgress implements [hcloud.IActionClient].
+func (m *ActionClient) WatchOverallProgress(ctx context.Context, actions []*hcloud.Action) (progressCh <-chan int, errCh <-chan error) {
+	args := m.Called(ctx, actions)
+	if value := args.Get(0); value != nil {
+		progressCh = value.(<-chan int)
+	}
+	if value := args.Get(1); value != nil {
+		errCh = value.(<-chan error)
+	}
+	return
+}
+
+// WatchProgress implements [hcloud.IActionClient].
+func (m *ActionClient) WatchProgress(ctx context.Context, action *hcloud.Action) (progressCh <-chan int, errCh <-chan error) {
+	args := m.Called(ctx, action)
+	if value := args.Get(0); value != nil {
+		progressCh = value.(<-chan int)
+	}
+	if value := args.Get(1); value != nil {
+		errCh = value.(<-chan error)
+	}
+	return
+}
+
+// WatchUpdates implements [hcloud.IActionClient].
+func (m *ActionClient) WatchUpdates(ctx context.Context, actions ...*hcloud.Action) (actionUpdateCh <-chan hcloud.ActionUpdate, errCh <-chan error) {
+	args := m.Called(ctx, actions)
+	if value := args.Get(0); value != nil {
+		actionUpdateCh = value.(<-chan hcloud.ActionUpdate)
+	}
+	if value := args.Get(1); value != nil {
+		errCh = value.(<-chan error)
+	}
+	return
+}
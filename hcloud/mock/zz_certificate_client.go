@@ -0,0 +1,207 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// CertificateClient is a mock implementation of [hcloud.ICertificateClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type CertificateClient struct {
+	mock.Mock
+}
+
+// NewCertificateClient returns a new [CertificateClient], whose expectations are asserted when the
+// test ends.
+func NewCertificateClient(t TestingT) *CertificateClient {
+	m := &CertificateClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.ICertificateClient].
+func (m *CertificateClient) All(ctx context.Context) (certificates []*hcloud.Certificate, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		certificates = value.([]*hcloud.Certificate)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.ICertificateClient].
+func (m *CertificateClient) AllWithOpts(ctx context.Context, opts hcloud.CertificateListOpts) (certificates []*hcloud.Certificate, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		certificates = value.([]*hcloud.Certificate)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.ICertificateClient].
+func (m *CertificateClient) Create(ctx context.Context, opts hcloud.CertificateCreateOpts) (certificate *hcloud.Certificate, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		certificate = value.(*hcloud.Certificate)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// CreateCertificate implements [hcloud.ICertificateClient].
+func (m *CertificateClient) CreateCertificate(ctx context.Context, opts hcloud.CertificateCreateOpts) (result hcloud.CertificateCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.CertificateCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.ICertificateClient].
+func (m *CertificateClient) Delete(ctx context.Context, certificate *hcloud.Certificate) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, certificate)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.ICertificateClient].
+func (m *CertificateClient) Get(ctx context.Context, idOrName string) (certificate *hcloud.Certificate, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		certificate = value.(*hcloud.Certificate)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.ICertificateClient].
+func (m *CertificateClient) GetByID(ctx context.Context, id int64) (certificate *hcloud.Certificate, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		certificate = value.(*hcloud.Certificate)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.ICertificateClient].
+func (m *CertificateClient) GetByName(ctx context.Context, name string) (certificate *hcloud.Certificate, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		certificate = value.(*hcloud.Certificate)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.ICertificateClient].
+func (m *CertificateClient) Iter(ctx context.Context, opts hcloud.CertificateListOpts) (certificates iter.Seq2[*hcloud.Certificate, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		certificates = value.(iter.Seq2[*hcloud.Certificate, error])
+	}
+	return
+}
+
+// List implements [hcloud.ICertificateClient].
+func (m *CertificateClient) List(ctx context.Context, opts hcloud.CertificateListOpts) (certificates []*hcloud.Certificate, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		certificates = value.([]*hcloud.Certificate)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RetryIssuance implements [hcloud.ICertificateClient].
+func (m *CertificateClient) RetryIssuance(ctx context.Context, certificate *hcloud.Certificate) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, certificate)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.ICertificateClient].
+func (m *CertificateClient) Update(ctx context.Context, certificate *hcloud.Certificate, opts hcloud.CertificateUpdateOpts) (result *hcloud.Certificate, response *hcloud.Response, err error) {
+	args := m.Called(ctx, certificate, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Certificate)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// WaitUntil implements [hcloud.ICertificateClient].
+func (m *CertificateClient) WaitUntil(ctx context.Context, certificate *hcloud.Certificate, condition func(*hcloud.Certificate) (bool, error)) (result *hcloud.Certificate, err error) {
+	args := m.Called(ctx, certificate, condition)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Certificate)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
@@ -0,0 +1,123 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// DatacenterClient is a mock implementation of [hcloud.IDatacenterClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type DatacenterClient struct {
+	mock.Mock
+}
+
+// NewDatacenterClient returns a new [DatacenterClient], whose expectations are asserted when the
+// test ends.
+func NewDatacenterClient(t TestingT) *DatacenterClient {
+	m := &DatacenterClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IDatacenterClient].
+func (m *DatacenterClient) All(ctx context.Context) (datacenters []*hcloud.Datacenter, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		datacenters = value.([]*hcloud.Datacenter)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IDatacenterClient].
+func (m *DatacenterClient) AllWithOpts(ctx context.Context, opts hcloud.DatacenterListOpts) (datacenters []*hcloud.Datacenter, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		datacenters = value.([]*hcloud.Datacenter)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IDatacenterClient].
+func (m *DatacenterClient) Get(ctx context.Context, idOrName string) (datacenter *hcloud.Datacenter, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		datacenter = value.(*hcloud.Datacenter)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IDatacenterClient].
+func (m *DatacenterClient) GetByID(ctx context.Context, id int64) (datacenter *hcloud.Datacenter, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		datacenter = value.(*hcloud.Datacenter)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IDatacenterClient].
+func (m *DatacenterClient) GetByName(ctx context.Context, name string) (datacenter *hcloud.Datacenter, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		datacenter = value.(*hcloud.Datacenter)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IDatacenterClient].
+func (m *DatacenterClient) Iter(ctx context.Context, opts hcloud.DatacenterListOpts) (datacenters iter.Seq2[*hcloud.Datacenter, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		datacenters = value.(iter.Seq2[*hcloud.Datacenter, error])
+	}
+	return
+}
+
+// List implements [hcloud.IDatacenterClient].
+func (m *DatacenterClient) List(ctx context.Context, opts hcloud.DatacenterListOpts) (datacenters []*hcloud.Datacenter, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		datacenters = value.([]*hcloud.Datacenter)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
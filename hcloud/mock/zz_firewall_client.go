@@ -0,0 +1,210 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// FirewallClient is a mock implementation of [hcloud.IFirewallClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type FirewallClient struct {
+	mock.Mock
+}
+
+// NewFirewallClient returns a new [FirewallClient], whose expectations are asserted when the
+// test ends.
+func NewFirewallClient(t TestingT) *FirewallClient {
+	m := &FirewallClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IFirewallClient].
+func (m *FirewallClient) All(ctx context.Context) (firewalls []*hcloud.Firewall, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		firewalls = value.([]*hcloud.Firewall)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IFirewallClient].
+func (m *FirewallClient) AllWithOpts(ctx context.Context, opts hcloud.FirewallListOpts) (firewalls []*hcloud.Firewall, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		firewalls = value.([]*hcloud.Firewall)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ApplyResources implements [hcloud.IFirewallClient].
+func (m *FirewallClient) ApplyResources(ctx context.Context, firewall *hcloud.Firewall, resources []hcloud.FirewallResource) (actions []*hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, firewall, resources)
+	if value := args.Get(0); value != nil {
+		actions = value.([]*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.IFirewallClient].
+func (m *FirewallClient) Create(ctx context.Context, opts hcloud.FirewallCreateOpts) (result hcloud.FirewallCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.FirewallCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.IFirewallClient].
+func (m *FirewallClient) Delete(ctx context.Context, firewall *hcloud.Firewall) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, firewall)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IFirewallClient].
+func (m *FirewallClient) Get(ctx context.Context, idOrName string) (firewall *hcloud.Firewall, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		firewall = value.(*hcloud.Firewall)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IFirewallClient].
+func (m *FirewallClient) GetByID(ctx context.Context, id int64) (firewall *hcloud.Firewall, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		firewall = value.(*hcloud.Firewall)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IFirewallClient].
+func (m *FirewallClient) GetByName(ctx context.Context, name string) (firewall *hcloud.Firewall, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		firewall = value.(*hcloud.Firewall)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IFirewallClient].
+func (m *FirewallClient) Iter(ctx context.Context, opts hcloud.FirewallListOpts) (firewalls iter.Seq2[*hcloud.Firewall, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		firewalls = value.(iter.Seq2[*hcloud.Firewall, error])
+	}
+	return
+}
+
+// List implements [hcloud.IFirewallClient].
+func (m *FirewallClient) List(ctx context.Context, opts hcloud.FirewallListOpts) (firewalls []*hcloud.Firewall, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		firewalls = value.([]*hcloud.Firewall)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RemoveResources implements [hcloud.IFirewallClient].
+func (m *FirewallClient) RemoveResources(ctx context.Context, firewall *hcloud.Firewall, resources []hcloud.FirewallResource) (actions []*hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, firewall, resources)
+	if value := args.Get(0); value != nil {
+		actions = value.([]*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// SetRules implements [hcloud.IFirewallClient].
+func (m *FirewallClient) SetRules(ctx context.Context, firewall *hcloud.Firewall, opts hcloud.FirewallSetRulesOpts) (actions []*hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, firewall, opts)
+	if value := args.Get(0); value != nil {
+		actions = value.([]*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.IFirewallClient].
+func (m *FirewallClient) Update(ctx context.Context, firewall *hcloud.Firewall, opts hcloud.FirewallUpdateOpts) (result *hcloud.Firewall, response *hcloud.Response, err error) {
+	args := m.Called(ctx, firewall, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Firewall)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
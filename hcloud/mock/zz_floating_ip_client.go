@@ -0,0 +1,225 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// FloatingIPClient is a mock implementation of [hcloud.IFloatingIPClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type FloatingIPClient struct {
+	mock.Mock
+}
+
+// NewFloatingIPClient returns a new [FloatingIPClient], whose expectations are asserted when the
+// test ends.
+func NewFloatingIPClient(t TestingT) *FloatingIPClient {
+	m := &FloatingIPClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) All(ctx context.Context) (floatingIPs []*hcloud.FloatingIP, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		floatingIPs = value.([]*hcloud.FloatingIP)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) AllWithOpts(ctx context.Context, opts hcloud.FloatingIPListOpts) (floatingIPs []*hcloud.FloatingIP, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		floatingIPs = value.([]*hcloud.FloatingIP)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Assign implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) Assign(ctx context.Context, floatingIP *hcloud.FloatingIP, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, floatingIP, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeDNSPtr implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) ChangeDNSPtr(ctx context.Context, floatingIP *hcloud.FloatingIP, ip string, ptr *string) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, floatingIP, ip, ptr)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeProtection implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) ChangeProtection(ctx context.Context, floatingIP *hcloud.FloatingIP, opts hcloud.FloatingIPChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, floatingIP, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) Create(ctx context.Context, opts hcloud.FloatingIPCreateOpts) (result hcloud.FloatingIPCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.FloatingIPCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) Delete(ctx context.Context, floatingIP *hcloud.FloatingIP) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, floatingIP)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) Get(ctx context.Context, idOrName string) (floatingIP *hcloud.FloatingIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		floatingIP = value.(*hcloud.FloatingIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) GetByID(ctx context.Context, id int64) (floatingIP *hcloud.FloatingIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		floatingIP = value.(*hcloud.FloatingIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) GetByName(ctx context.Context, name string) (floatingIP *hcloud.FloatingIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		floatingIP = value.(*hcloud.FloatingIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) Iter(ctx context.Context, opts hcloud.FloatingIPListOpts) (floatingIPs iter.Seq2[*hcloud.FloatingIP, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		floatingIPs = value.(iter.Seq2[*hcloud.FloatingIP, error])
+	}
+	return
+}
+
+// List implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) List(ctx context.Context, opts hcloud.FloatingIPListOpts) (floatingIPs []*hcloud.FloatingIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		floatingIPs = value.([]*hcloud.FloatingIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Unassign implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) Unassign(ctx context.Context, floatingIP *hcloud.FloatingIP) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, floatingIP)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.IFloatingIPClient].
+func (m *FloatingIPClient) Update(ctx context.Context, floatingIP *hcloud.FloatingIP, opts hcloud.FloatingIPUpdateOpts) (result *hcloud.FloatingIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, floatingIP, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.FloatingIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
@@ -0,0 +1,207 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// ImageClient is a mock implementation of [hcloud.IImageClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type ImageClient struct {
+	mock.Mock
+}
+
+// NewImageClient returns a new [ImageClient], whose expectations are asserted when the
+// test ends.
+func NewImageClient(t TestingT) *ImageClient {
+	m := &ImageClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IImageClient].
+func (m *ImageClient) All(ctx context.Context) (images []*hcloud.Image, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		images = value.([]*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IImageClient].
+func (m *ImageClient) AllWithOpts(ctx context.Context, opts hcloud.ImageListOpts) (images []*hcloud.Image, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		images = value.([]*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeProtection implements [hcloud.IImageClient].
+func (m *ImageClient) ChangeProtection(ctx context.Context, image *hcloud.Image, opts hcloud.ImageChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, image, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.IImageClient].
+func (m *ImageClient) Delete(ctx context.Context, image *hcloud.Image) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, image)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IImageClient].
+func (m *ImageClient) Get(ctx context.Context, idOrName string) (image *hcloud.Image, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		image = value.(*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IImageClient].
+func (m *ImageClient) GetByID(ctx context.Context, id int64) (image *hcloud.Image, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		image = value.(*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IImageClient].
+func (m *ImageClient) GetByName(ctx context.Context, name string) (image *hcloud.Image, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		image = value.(*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByNameAndArchitecture implements [hcloud.IImageClient].
+func (m *ImageClient) GetByNameAndArchitecture(ctx context.Context, name string, architecture hcloud.Architecture) (image *hcloud.Image, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name, architecture)
+	if value := args.Get(0); value != nil {
+		image = value.(*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetForArchitecture implements [hcloud.IImageClient].
+func (m *ImageClient) GetForArchitecture(ctx context.Context, idOrName string, architecture hcloud.Architecture) (image *hcloud.Image, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName, architecture)
+	if value := args.Get(0); value != nil {
+		image = value.(*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IImageClient].
+func (m *ImageClient) Iter(ctx context.Context, opts hcloud.ImageListOpts) (images iter.Seq2[*hcloud.Image, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		images = value.(iter.Seq2[*hcloud.Image, error])
+	}
+	return
+}
+
+// List implements [hcloud.IImageClient].
+func (m *ImageClient) List(ctx context.Context, opts hcloud.ImageListOpts) (images []*hcloud.Image, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		images = value.([]*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.IImageClient].
+func (m *ImageClient) Update(ctx context.Context, image *hcloud.Image, opts hcloud.ImageUpdateOpts) (result *hcloud.Image, response *hcloud.Response, err error) {
+	args := m.Called(ctx, image, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// WaitUntil implements [hcloud.IImageClient].
+func (m *ImageClient) WaitUntil(ctx context.Context, image *hcloud.Image, condition func(*hcloud.Image) (bool, error)) (result *hcloud.Image, err error) {
+	args := m.Called(ctx, image, condition)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Image)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
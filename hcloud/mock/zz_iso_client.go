@@ -0,0 +1,123 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// ISOClient is a mock implementation of [hcloud.IISOClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type ISOClient struct {
+	mock.Mock
+}
+
+// NewISOClient returns a new [ISOClient], whose expectations are asserted when the
+// test ends.
+func NewISOClient(t TestingT) *ISOClient {
+	m := &ISOClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IISOClient].
+func (m *ISOClient) All(ctx context.Context) (isos []*hcloud.ISO, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		isos = value.([]*hcloud.ISO)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IISOClient].
+func (m *ISOClient) AllWithOpts(ctx context.Context, opts hcloud.ISOListOpts) (isos []*hcloud.ISO, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		isos = value.([]*hcloud.ISO)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IISOClient].
+func (m *ISOClient) Get(ctx context.Context, idOrName string) (iso *hcloud.ISO, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		iso = value.(*hcloud.ISO)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IISOClient].
+func (m *ISOClient) GetByID(ctx context.Context, id int64) (iso *hcloud.ISO, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		iso = value.(*hcloud.ISO)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IISOClient].
+func (m *ISOClient) GetByName(ctx context.Context, name string) (iso *hcloud.ISO, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		iso = value.(*hcloud.ISO)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IISOClient].
+func (m *ISOClient) Iter(ctx context.Context, opts hcloud.ISOListOpts) (isos iter.Seq2[*hcloud.ISO, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		isos = value.(iter.Seq2[*hcloud.ISO, error])
+	}
+	return
+}
+
+// List implements [hcloud.IISOClient].
+func (m *ISOClient) List(ctx context.Context, opts hcloud.ISOListOpts) (isos []*hcloud.ISO, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		isos = value.([]*hcloud.ISO)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
@@ -0,0 +1,436 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+	"net"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// LoadBalancerClient is a mock implementation of [hcloud.ILoadBalancerClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type LoadBalancerClient struct {
+	mock.Mock
+}
+
+// NewLoadBalancerClient returns a new [LoadBalancerClient], whose expectations are asserted when the
+// test ends.
+func NewLoadBalancerClient(t TestingT) *LoadBalancerClient {
+	m := &LoadBalancerClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// AddIPTarget implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) AddIPTarget(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerAddIPTargetOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AddLabelSelectorTarget implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) AddLabelSelectorTarget(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerAddLabelSelectorTargetOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AddServerTarget implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) AddServerTarget(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerAddServerTargetOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AddService implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) AddService(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerAddServiceOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// All implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) All(ctx context.Context) (loadBalancers []*hcloud.LoadBalancer, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		loadBalancers = value.([]*hcloud.LoadBalancer)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) AllWithOpts(ctx context.Context, opts hcloud.LoadBalancerListOpts) (loadBalancers []*hcloud.LoadBalancer, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		loadBalancers = value.([]*hcloud.LoadBalancer)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AttachToNetwork implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) AttachToNetwork(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerAttachToNetworkOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeAlgorithm implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) ChangeAlgorithm(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerChangeAlgorithmOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeDNSPtr implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) ChangeDNSPtr(ctx context.Context, lb *hcloud.LoadBalancer, ip string, ptr *string) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, lb, ip, ptr)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeProtection implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) ChangeProtection(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeType implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) ChangeType(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerChangeTypeOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) Create(ctx context.Context, opts hcloud.LoadBalancerCreateOpts) (result hcloud.LoadBalancerCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.LoadBalancerCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) Delete(ctx context.Context, loadBalancer *hcloud.LoadBalancer) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DeleteService implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) DeleteService(ctx context.Context, loadBalancer *hcloud.LoadBalancer, listenPort int) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, listenPort)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DetachFromNetwork implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) DetachFromNetwork(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerDetachFromNetworkOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DisablePublicInterface implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) DisablePublicInterface(ctx context.Context, loadBalancer *hcloud.LoadBalancer) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// EnablePublicInterface implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) EnablePublicInterface(ctx context.Context, loadBalancer *hcloud.LoadBalancer) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) Get(ctx context.Context, idOrName string) (loadBalancer *hcloud.LoadBalancer, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		loadBalancer = value.(*hcloud.LoadBalancer)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) GetByID(ctx context.Context, id int64) (loadBalancer *hcloud.LoadBalancer, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		loadBalancer = value.(*hcloud.LoadBalancer)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) GetByName(ctx context.Context, name string) (loadBalancer *hcloud.LoadBalancer, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		loadBalancer = value.(*hcloud.LoadBalancer)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetMetrics implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) GetMetrics(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerGetMetricsOpts) (loadBalancerMetrics *hcloud.LoadBalancerMetrics, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		loadBalancerMetrics = value.(*hcloud.LoadBalancerMetrics)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) Iter(ctx context.Context, opts hcloud.LoadBalancerListOpts) (loadBalancers iter.Seq2[*hcloud.LoadBalancer, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		loadBalancers = value.(iter.Seq2[*hcloud.LoadBalancer, error])
+	}
+	return
+}
+
+// List implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) List(ctx context.Context, opts hcloud.LoadBalancerListOpts) (loadBalancers []*hcloud.LoadBalancer, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		loadBalancers = value.([]*hcloud.LoadBalancer)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RemoveIPTarget implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) RemoveIPTarget(ctx context.Context, loadBalancer *hcloud.LoadBalancer, ip net.IP) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, ip)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RemoveLabelSelectorTarget implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) RemoveLabelSelectorTarget(ctx context.Context, loadBalancer *hcloud.LoadBalancer, labelSelector string) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, labelSelector)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RemoveServerTarget implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) RemoveServerTarget(ctx context.Context, loadBalancer *hcloud.LoadBalancer, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) Update(ctx context.Context, loadBalancer *hcloud.LoadBalancer, opts hcloud.LoadBalancerUpdateOpts) (result *hcloud.LoadBalancer, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.LoadBalancer)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// UpdateService implements [hcloud.ILoadBalancerClient].
+func (m *LoadBalancerClient) UpdateService(ctx context.Context, loadBalancer *hcloud.LoadBalancer, listenPort int, opts hcloud.LoadBalancerUpdateServiceOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, loadBalancer, listenPort, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
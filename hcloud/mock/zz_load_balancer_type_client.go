@@ -0,0 +1,123 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// LoadBalancerTypeClient is a mock implementation of [hcloud.ILoadBalancerTypeClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type LoadBalancerTypeClient struct {
+	mock.Mock
+}
+
+// NewLoadBalancerTypeClient returns a new [LoadBalancerTypeClient], whose expectations are asserted when the
+// test ends.
+func NewLoadBalancerTypeClient(t TestingT) *LoadBalancerTypeClient {
+	m := &LoadBalancerTypeClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.ILoadBalancerTypeClient].
+func (m *LoadBalancerTypeClient) All(ctx context.Context) (loadBalancerTypes []*hcloud.LoadBalancerType, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		loadBalancerTypes = value.([]*hcloud.LoadBalancerType)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.ILoadBalancerTypeClient].
+func (m *LoadBalancerTypeClient) AllWithOpts(ctx context.Context, opts hcloud.LoadBalancerTypeListOpts) (loadBalancerTypes []*hcloud.LoadBalancerType, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		loadBalancerTypes = value.([]*hcloud.LoadBalancerType)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.ILoadBalancerTypeClient].
+func (m *LoadBalancerTypeClient) Get(ctx context.Context, idOrName string) (loadBalancerType *hcloud.LoadBalancerType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		loadBalancerType = value.(*hcloud.LoadBalancerType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.ILoadBalancerTypeClient].
+func (m *LoadBalancerTypeClient) GetByID(ctx context.Context, id int64) (loadBalancerType *hcloud.LoadBalancerType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		loadBalancerType = value.(*hcloud.LoadBalancerType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.ILoadBalancerTypeClient].
+func (m *LoadBalancerTypeClient) GetByName(ctx context.Context, name string) (loadBalancerType *hcloud.LoadBalancerType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		loadBalancerType = value.(*hcloud.LoadBalancerType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.ILoadBalancerTypeClient].
+func (m *LoadBalancerTypeClient) Iter(ctx context.Context, opts hcloud.LoadBalancerTypeListOpts) (loadBalancerTypes iter.Seq2[*hcloud.LoadBalancerType, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		loadBalancerTypes = value.(iter.Seq2[*hcloud.LoadBalancerType, error])
+	}
+	return
+}
+
+// List implements [hcloud.ILoadBalancerTypeClient].
+func (m *LoadBalancerTypeClient) List(ctx context.Context, opts hcloud.LoadBalancerTypeListOpts) (loadBalancerTypes []*hcloud.LoadBalancerType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		loadBalancerTypes = value.([]*hcloud.LoadBalancerType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
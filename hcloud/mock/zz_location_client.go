@@ -0,0 +1,123 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// LocationClient is a mock implementation of [hcloud.ILocationClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type LocationClient struct {
+	mock.Mock
+}
+
+// NewLocationClient returns a new [LocationClient], whose expectations are asserted when the
+// test ends.
+func NewLocationClient(t TestingT) *LocationClient {
+	m := &LocationClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.ILocationClient].
+func (m *LocationClient) All(ctx context.Context) (locations []*hcloud.Location, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		locations = value.([]*hcloud.Location)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.ILocationClient].
+func (m *LocationClient) AllWithOpts(ctx context.Context, opts hcloud.LocationListOpts) (locations []*hcloud.Location, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		locations = value.([]*hcloud.Location)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.ILocationClient].
+func (m *LocationClient) Get(ctx context.Context, idOrName string) (location *hcloud.Location, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		location = value.(*hcloud.Location)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.ILocationClient].
+func (m *LocationClient) GetByID(ctx context.Context, id int64) (location *hcloud.Location, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		location = value.(*hcloud.Location)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.ILocationClient].
+func (m *LocationClient) GetByName(ctx context.Context, name string) (location *hcloud.Location, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		location = value.(*hcloud.Location)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.ILocationClient].
+func (m *LocationClient) Iter(ctx context.Context, opts hcloud.LocationListOpts) (locations iter.Seq2[*hcloud.Location, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		locations = value.(iter.Seq2[*hcloud.Location, error])
+	}
+	return
+}
+
+// List implements [hcloud.ILocationClient].
+func (m *LocationClient) List(ctx context.Context, opts hcloud.LocationListOpts) (locations []*hcloud.Location, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		locations = value.([]*hcloud.Location)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
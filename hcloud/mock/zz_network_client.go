@@ -0,0 +1,255 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// NetworkClient is a mock implementation of [hcloud.INetworkClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type NetworkClient struct {
+	mock.Mock
+}
+
+// NewNetworkClient returns a new [NetworkClient], whose expectations are asserted when the
+// test ends.
+func NewNetworkClient(t TestingT) *NetworkClient {
+	m := &NetworkClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// AddRoute implements [hcloud.INetworkClient].
+func (m *NetworkClient) AddRoute(ctx context.Context, network *hcloud.Network, opts hcloud.NetworkAddRouteOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, network, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AddSubnet implements [hcloud.INetworkClient].
+func (m *NetworkClient) AddSubnet(ctx context.Context, network *hcloud.Network, opts hcloud.NetworkAddSubnetOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, network, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// All implements [hcloud.INetworkClient].
+func (m *NetworkClient) All(ctx context.Context) (networks []*hcloud.Network, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		networks = value.([]*hcloud.Network)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.INetworkClient].
+func (m *NetworkClient) AllWithOpts(ctx context.Context, opts hcloud.NetworkListOpts) (networks []*hcloud.Network, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		networks = value.([]*hcloud.Network)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeIPRange implements [hcloud.INetworkClient].
+func (m *NetworkClient) ChangeIPRange(ctx context.Context, network *hcloud.Network, opts hcloud.NetworkChangeIPRangeOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, network, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeProtection implements [hcloud.INetworkClient].
+func (m *NetworkClient) ChangeProtection(ctx context.Context, network *hcloud.Network, opts hcloud.NetworkChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, network, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.INetworkClient].
+func (m *NetworkClient) Create(ctx context.Context, opts hcloud.NetworkCreateOpts) (network *hcloud.Network, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		network = value.(*hcloud.Network)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.INetworkClient].
+func (m *NetworkClient) Delete(ctx context.Context, network *hcloud.Network) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, network)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DeleteRoute implements [hcloud.INetworkClient].
+func (m *NetworkClient) DeleteRoute(ctx context.Context, network *hcloud.Network, opts hcloud.NetworkDeleteRouteOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, network, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DeleteSubnet implements [hcloud.INetworkClient].
+func (m *NetworkClient) DeleteSubnet(ctx context.Context, network *hcloud.Network, opts hcloud.NetworkDeleteSubnetOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, network, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.INetworkClient].
+func (m *NetworkClient) Get(ctx context.Context, idOrName string) (network *hcloud.Network, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		network = value.(*hcloud.Network)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.INetworkClient].
+func (m *NetworkClient) GetByID(ctx context.Context, id int64) (network *hcloud.Network, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		network = value.(*hcloud.Network)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.INetworkClient].
+func (m *NetworkClient) GetByName(ctx context.Context, name string) (network *hcloud.Network, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		network = value.(*hcloud.Network)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.INetworkClient].
+func (m *NetworkClient) Iter(ctx context.Context, opts hcloud.NetworkListOpts) (networks iter.Seq2[*hcloud.Network, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		networks = value.(iter.Seq2[*hcloud.Network, error])
+	}
+	return
+}
+
+// List implements [hcloud.INetworkClient].
+func (m *NetworkClient) List(ctx context.Context, opts hcloud.NetworkListOpts) (networks []*hcloud.Network, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		networks = value.([]*hcloud.Network)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.INetworkClient].
+func (m *NetworkClient) Update(ctx context.Context, network *hcloud.Network, opts hcloud.NetworkUpdateOpts) (result *hcloud.Network, response *hcloud.Response, err error) {
+	args := m.Called(ctx, network, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Network)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
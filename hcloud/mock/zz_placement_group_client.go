@@ -0,0 +1,165 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// PlacementGroupClient is a mock implementation of [hcloud.IPlacementGroupClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type PlacementGroupClient struct {
+	mock.Mock
+}
+
+// NewPlacementGroupClient returns a new [PlacementGroupClient], whose expectations are asserted when the
+// test ends.
+func NewPlacementGroupClient(t TestingT) *PlacementGroupClient {
+	m := &PlacementGroupClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) All(ctx context.Context) (placementGroups []*hcloud.PlacementGroup, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		placementGroups = value.([]*hcloud.PlacementGroup)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) AllWithOpts(ctx context.Context, opts hcloud.PlacementGroupListOpts) (placementGroups []*hcloud.PlacementGroup, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		placementGroups = value.([]*hcloud.PlacementGroup)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) Create(ctx context.Context, opts hcloud.PlacementGroupCreateOpts) (result hcloud.PlacementGroupCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.PlacementGroupCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) Delete(ctx context.Context, placementGroup *hcloud.PlacementGroup) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, placementGroup)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) Get(ctx context.Context, idOrName string) (placementGroup *hcloud.PlacementGroup, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		placementGroup = value.(*hcloud.PlacementGroup)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) GetByID(ctx context.Context, id int64) (placementGroup *hcloud.PlacementGroup, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		placementGroup = value.(*hcloud.PlacementGroup)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) GetByName(ctx context.Context, name string) (placementGroup *hcloud.PlacementGroup, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		placementGroup = value.(*hcloud.PlacementGroup)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) Iter(ctx context.Context, opts hcloud.PlacementGroupListOpts) (placementGroups iter.Seq2[*hcloud.PlacementGroup, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		placementGroups = value.(iter.Seq2[*hcloud.PlacementGroup, error])
+	}
+	return
+}
+
+// List implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) List(ctx context.Context, opts hcloud.PlacementGroupListOpts) (placementGroups []*hcloud.PlacementGroup, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		placementGroups = value.([]*hcloud.PlacementGroup)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.IPlacementGroupClient].
+func (m *PlacementGroupClient) Update(ctx context.Context, placementGroup *hcloud.PlacementGroup, opts hcloud.PlacementGroupUpdateOpts) (result *hcloud.PlacementGroup, response *hcloud.Response, err error) {
+	args := m.Called(ctx, placementGroup, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.PlacementGroup)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
@@ -0,0 +1,44 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// PricingClient is a mock implementation of [hcloud.IPricingClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type PricingClient struct {
+	mock.Mock
+}
+
+// NewPricingClient returns a new [PricingClient], whose expectations are asserted when the
+// test ends.
+func NewPricingClient(t TestingT) *PricingClient {
+	m := &PricingClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Get implements [hcloud.IPricingClient].
+func (m *PricingClient) Get(ctx context.Context) (pricing hcloud.Pricing, response *hcloud.Response, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		pricing = value.(hcloud.Pricing)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
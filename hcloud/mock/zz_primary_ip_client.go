@@ -0,0 +1,240 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// PrimaryIPClient is a mock implementation of [hcloud.IPrimaryIPClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type PrimaryIPClient struct {
+	mock.Mock
+}
+
+// NewPrimaryIPClient returns a new [PrimaryIPClient], whose expectations are asserted when the
+// test ends.
+func NewPrimaryIPClient(t TestingT) *PrimaryIPClient {
+	m := &PrimaryIPClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) All(ctx context.Context) (primaryIPs []*hcloud.PrimaryIP, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		primaryIPs = value.([]*hcloud.PrimaryIP)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) AllWithOpts(ctx context.Context, opts hcloud.PrimaryIPListOpts) (primaryIPs []*hcloud.PrimaryIP, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		primaryIPs = value.([]*hcloud.PrimaryIP)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Assign implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) Assign(ctx context.Context, opts hcloud.PrimaryIPAssignOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeDNSPtr implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) ChangeDNSPtr(ctx context.Context, opts hcloud.PrimaryIPChangeDNSPtrOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeProtection implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) ChangeProtection(ctx context.Context, opts hcloud.PrimaryIPChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) Create(ctx context.Context, opts hcloud.PrimaryIPCreateOpts) (result *hcloud.PrimaryIPCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.PrimaryIPCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) Delete(ctx context.Context, primaryIP *hcloud.PrimaryIP) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, primaryIP)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) Get(ctx context.Context, idOrName string) (primaryIP *hcloud.PrimaryIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		primaryIP = value.(*hcloud.PrimaryIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) GetByID(ctx context.Context, id int64) (primaryIP *hcloud.PrimaryIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		primaryIP = value.(*hcloud.PrimaryIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByIP implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) GetByIP(ctx context.Context, ip string) (primaryIP *hcloud.PrimaryIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, ip)
+	if value := args.Get(0); value != nil {
+		primaryIP = value.(*hcloud.PrimaryIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) GetByName(ctx context.Context, name string) (primaryIP *hcloud.PrimaryIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		primaryIP = value.(*hcloud.PrimaryIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) Iter(ctx context.Context, opts hcloud.PrimaryIPListOpts) (primaryIPs iter.Seq2[*hcloud.PrimaryIP, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		primaryIPs = value.(iter.Seq2[*hcloud.PrimaryIP, error])
+	}
+	return
+}
+
+// List implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) List(ctx context.Context, opts hcloud.PrimaryIPListOpts) (primaryIPs []*hcloud.PrimaryIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		primaryIPs = value.([]*hcloud.PrimaryIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Unassign implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) Unassign(ctx context.Context, id int64) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.IPrimaryIPClient].
+func (m *PrimaryIPClient) Update(ctx context.Context, primaryIP *hcloud.PrimaryIP, opts hcloud.PrimaryIPUpdateOpts) (result *hcloud.PrimaryIP, response *hcloud.Response, err error) {
+	args := m.Called(ctx, primaryIP, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.PrimaryIP)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
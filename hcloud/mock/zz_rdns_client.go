@@ -0,0 +1,45 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"net"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// RDNSClient is a mock implementation of [hcloud.IRDNSClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type RDNSClient struct {
+	mock.Mock
+}
+
+// NewRDNSClient returns a new [RDNSClient], whose expectations are asserted when the
+// test ends.
+func NewRDNSClient(t TestingT) *RDNSClient {
+	m := &RDNSClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// ChangeDNSPtr implements [hcloud.IRDNSClient].
+func (m *RDNSClient) ChangeDNSPtr(ctx context.Context, rdns hcloud.RDNSSupporter, ip net.IP, ptr *string) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, rdns, ip, ptr)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
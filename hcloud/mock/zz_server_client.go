@@ -0,0 +1,567 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServerClient is a mock implementation of [hcloud.IServerClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type ServerClient struct {
+	mock.Mock
+}
+
+// NewServerClient returns a new [ServerClient], whose expectations are asserted when the
+// test ends.
+func NewServerClient(t TestingT) *ServerClient {
+	m := &ServerClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// AddToPlacementGroup implements [hcloud.IServerClient].
+func (m *ServerClient) AddToPlacementGroup(ctx context.Context, server *hcloud.Server, placementGroup *hcloud.PlacementGroup) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, placementGroup)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// All implements [hcloud.IServerClient].
+func (m *ServerClient) All(ctx context.Context) (servers []*hcloud.Server, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		servers = value.([]*hcloud.Server)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IServerClient].
+func (m *ServerClient) AllWithOpts(ctx context.Context, opts hcloud.ServerListOpts) (servers []*hcloud.Server, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		servers = value.([]*hcloud.Server)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AttachISO implements [hcloud.IServerClient].
+func (m *ServerClient) AttachISO(ctx context.Context, server *hcloud.Server, iso *hcloud.ISO) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, iso)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AttachToNetwork implements [hcloud.IServerClient].
+func (m *ServerClient) AttachToNetwork(ctx context.Context, server *hcloud.Server, opts hcloud.ServerAttachToNetworkOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeAliasIPs implements [hcloud.IServerClient].
+func (m *ServerClient) ChangeAliasIPs(ctx context.Context, server *hcloud.Server, opts hcloud.ServerChangeAliasIPsOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeDNSPtr implements [hcloud.IServerClient].
+func (m *ServerClient) ChangeDNSPtr(ctx context.Context, server *hcloud.Server, ip string, ptr *string) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, ip, ptr)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeProtection implements [hcloud.IServerClient].
+func (m *ServerClient) ChangeProtection(ctx context.Context, server *hcloud.Server, opts hcloud.ServerChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeType implements [hcloud.IServerClient].
+func (m *ServerClient) ChangeType(ctx context.Context, server *hcloud.Server, opts hcloud.ServerChangeTypeOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.IServerClient].
+func (m *ServerClient) Create(ctx context.Context, opts hcloud.ServerCreateOpts) (result hcloud.ServerCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ServerCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// CreateImage implements [hcloud.IServerClient].
+func (m *ServerClient) CreateImage(ctx context.Context, server *hcloud.Server, opts *hcloud.ServerCreateImageOpts) (result hcloud.ServerCreateImageResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ServerCreateImageResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.IServerClient].
+func (m *ServerClient) Delete(ctx context.Context, server *hcloud.Server) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DeleteWithResult implements [hcloud.IServerClient].
+func (m *ServerClient) DeleteWithResult(ctx context.Context, server *hcloud.Server) (result *hcloud.ServerDeleteResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.ServerDeleteResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DetachFromNetwork implements [hcloud.IServerClient].
+func (m *ServerClient) DetachFromNetwork(ctx context.Context, server *hcloud.Server, opts hcloud.ServerDetachFromNetworkOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DetachISO implements [hcloud.IServerClient].
+func (m *ServerClient) DetachISO(ctx context.Context, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DisableBackup implements [hcloud.IServerClient].
+func (m *ServerClient) DisableBackup(ctx context.Context, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DisableRescue implements [hcloud.IServerClient].
+func (m *ServerClient) DisableRescue(ctx context.Context, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// EnableBackup implements [hcloud.IServerClient].
+func (m *ServerClient) EnableBackup(ctx context.Context, server *hcloud.Server, window string) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, window)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// EnableRescue implements [hcloud.IServerClient].
+func (m *ServerClient) EnableRescue(ctx context.Context, server *hcloud.Server, opts hcloud.ServerEnableRescueOpts) (result hcloud.ServerEnableRescueResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ServerEnableRescueResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IServerClient].
+func (m *ServerClient) Get(ctx context.Context, idOrName string) (server *hcloud.Server, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		server = value.(*hcloud.Server)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IServerClient].
+func (m *ServerClient) GetByID(ctx context.Context, id int64) (server *hcloud.Server, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		server = value.(*hcloud.Server)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IServerClient].
+func (m *ServerClient) GetByName(ctx context.Context, name string) (server *hcloud.Server, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		server = value.(*hcloud.Server)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetMetrics implements [hcloud.IServerClient].
+func (m *ServerClient) GetMetrics(ctx context.Context, server *hcloud.Server, opts hcloud.ServerGetMetricsOpts) (serverMetrics *hcloud.ServerMetrics, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		serverMetrics = value.(*hcloud.ServerMetrics)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IServerClient].
+func (m *ServerClient) Iter(ctx context.Context, opts hcloud.ServerListOpts) (servers iter.Seq2[*hcloud.Server, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		servers = value.(iter.Seq2[*hcloud.Server, error])
+	}
+	return
+}
+
+// List implements [hcloud.IServerClient].
+func (m *ServerClient) List(ctx context.Context, opts hcloud.ServerListOpts) (servers []*hcloud.Server, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		servers = value.([]*hcloud.Server)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Poweroff implements [hcloud.IServerClient].
+func (m *ServerClient) Poweroff(ctx context.Context, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Poweron implements [hcloud.IServerClient].
+func (m *ServerClient) Poweron(ctx context.Context, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Reboot implements [hcloud.IServerClient].
+func (m *ServerClient) Reboot(ctx context.Context, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Rebuild implements [hcloud.IServerClient].
+func (m *ServerClient) Rebuild(ctx context.Context, server *hcloud.Server, opts hcloud.ServerRebuildOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RebuildWithResult implements [hcloud.IServerClient].
+func (m *ServerClient) RebuildWithResult(ctx context.Context, server *hcloud.Server, opts hcloud.ServerRebuildOpts) (result hcloud.ServerRebuildResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ServerRebuildResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RemoveFromPlacementGroup implements [hcloud.IServerClient].
+func (m *ServerClient) RemoveFromPlacementGroup(ctx context.Context, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RequestConsole implements [hcloud.IServerClient].
+func (m *ServerClient) RequestConsole(ctx context.Context, server *hcloud.Server) (result hcloud.ServerRequestConsoleResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ServerRequestConsoleResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Reset implements [hcloud.IServerClient].
+func (m *ServerClient) Reset(ctx context.Context, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ResetPassword implements [hcloud.IServerClient].
+func (m *ServerClient) ResetPassword(ctx context.Context, server *hcloud.Server) (result hcloud.ServerResetPasswordResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ServerResetPasswordResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Shutdown implements [hcloud.IServerClient].
+func (m *ServerClient) Shutdown(ctx context.Context, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.IServerClient].
+func (m *ServerClient) Update(ctx context.Context, server *hcloud.Server, opts hcloud.ServerUpdateOpts) (result *hcloud.Server, response *hcloud.Response, err error) {
+	args := m.Called(ctx, server, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Server)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// WaitUntil implements [hcloud.IServerClient].
+func (m *ServerClient) WaitUntil(ctx context.Context, server *hcloud.Server, condition func(*hcloud.Server) (bool, error)) (result *hcloud.Server, err error) {
+	args := m.Called(ctx, server, condition)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Server)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
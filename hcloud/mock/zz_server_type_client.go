@@ -0,0 +1,123 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// ServerTypeClient is a mock implementation of [hcloud.IServerTypeClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type ServerTypeClient struct {
+	mock.Mock
+}
+
+// NewServerTypeClient returns a new [ServerTypeClient], whose expectations are asserted when the
+// test ends.
+func NewServerTypeClient(t TestingT) *ServerTypeClient {
+	m := &ServerTypeClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IServerTypeClient].
+func (m *ServerTypeClient) All(ctx context.Context) (serverTypes []*hcloud.ServerType, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		serverTypes = value.([]*hcloud.ServerType)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IServerTypeClient].
+func (m *ServerTypeClient) AllWithOpts(ctx context.Context, opts hcloud.ServerTypeListOpts) (serverTypes []*hcloud.ServerType, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		serverTypes = value.([]*hcloud.ServerType)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IServerTypeClient].
+func (m *ServerTypeClient) Get(ctx context.Context, idOrName string) (serverType *hcloud.ServerType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		serverType = value.(*hcloud.ServerType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IServerTypeClient].
+func (m *ServerTypeClient) GetByID(ctx context.Context, id int64) (serverType *hcloud.ServerType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		serverType = value.(*hcloud.ServerType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IServerTypeClient].
+func (m *ServerTypeClient) GetByName(ctx context.Context, name string) (serverType *hcloud.ServerType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		serverType = value.(*hcloud.ServerType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IServerTypeClient].
+func (m *ServerTypeClient) Iter(ctx context.Context, opts hcloud.ServerTypeListOpts) (serverTypes iter.Seq2[*hcloud.ServerType, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		serverTypes = value.(iter.Seq2[*hcloud.ServerType, error])
+	}
+	return
+}
+
+// List implements [hcloud.IServerTypeClient].
+func (m *ServerTypeClient) List(ctx context.Context, opts hcloud.ServerTypeListOpts) (serverTypes []*hcloud.ServerType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		serverTypes = value.([]*hcloud.ServerType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
@@ -0,0 +1,180 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// SSHKeyClient is a mock implementation of [hcloud.ISSHKeyClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type SSHKeyClient struct {
+	mock.Mock
+}
+
+// NewSSHKeyClient returns a new [SSHKeyClient], whose expectations are asserted when the
+// test ends.
+func NewSSHKeyClient(t TestingT) *SSHKeyClient {
+	m := &SSHKeyClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) All(ctx context.Context) (sshKeys []*hcloud.SSHKey, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		sshKeys = value.([]*hcloud.SSHKey)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) AllWithOpts(ctx context.Context, opts hcloud.SSHKeyListOpts) (sshKeys []*hcloud.SSHKey, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		sshKeys = value.([]*hcloud.SSHKey)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) Create(ctx context.Context, opts hcloud.SSHKeyCreateOpts) (sshKey *hcloud.SSHKey, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		sshKey = value.(*hcloud.SSHKey)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) Delete(ctx context.Context, sshKey *hcloud.SSHKey) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, sshKey)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) Get(ctx context.Context, idOrName string) (sshKey *hcloud.SSHKey, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		sshKey = value.(*hcloud.SSHKey)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByFingerprint implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) GetByFingerprint(ctx context.Context, fingerprint string) (sshKey *hcloud.SSHKey, response *hcloud.Response, err error) {
+	args := m.Called(ctx, fingerprint)
+	if value := args.Get(0); value != nil {
+		sshKey = value.(*hcloud.SSHKey)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) GetByID(ctx context.Context, id int64) (sshKey *hcloud.SSHKey, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		sshKey = value.(*hcloud.SSHKey)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) GetByName(ctx context.Context, name string) (sshKey *hcloud.SSHKey, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		sshKey = value.(*hcloud.SSHKey)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) Iter(ctx context.Context, opts hcloud.SSHKeyListOpts) (sshKeys iter.Seq2[*hcloud.SSHKey, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		sshKeys = value.(iter.Seq2[*hcloud.SSHKey, error])
+	}
+	return
+}
+
+// List implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) List(ctx context.Context, opts hcloud.SSHKeyListOpts) (sshKeys []*hcloud.SSHKey, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		sshKeys = value.([]*hcloud.SSHKey)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.ISSHKeyClient].
+func (m *SSHKeyClient) Update(ctx context.Context, sshKey *hcloud.SSHKey, opts hcloud.SSHKeyUpdateOpts) (result *hcloud.SSHKey, response *hcloud.Response, err error) {
+	args := m.Called(ctx, sshKey, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.SSHKey)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
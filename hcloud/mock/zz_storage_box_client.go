@@ -0,0 +1,636 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// StorageBoxClient is a mock implementation of [hcloud.IStorageBoxClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type StorageBoxClient struct {
+	mock.Mock
+}
+
+// NewStorageBoxClient returns a new [StorageBoxClient], whose expectations are asserted when the
+// test ends.
+func NewStorageBoxClient(t TestingT) *StorageBoxClient {
+	m := &StorageBoxClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) All(ctx context.Context) (storageBoxes []*hcloud.StorageBox, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		storageBoxes = value.([]*hcloud.StorageBox)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllSnapshots implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) AllSnapshots(ctx context.Context, storageBox *hcloud.StorageBox) (storageBoxSnapshots []*hcloud.StorageBoxSnapshot, err error) {
+	args := m.Called(ctx, storageBox)
+	if value := args.Get(0); value != nil {
+		storageBoxSnapshots = value.([]*hcloud.StorageBoxSnapshot)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllSnapshotsWithOpts implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) AllSnapshotsWithOpts(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxSnapshotListOpts) (storageBoxSnapshots []*hcloud.StorageBoxSnapshot, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxSnapshots = value.([]*hcloud.StorageBoxSnapshot)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllSubaccounts implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) AllSubaccounts(ctx context.Context, storageBox *hcloud.StorageBox) (storageBoxSubaccounts []*hcloud.StorageBoxSubaccount, err error) {
+	args := m.Called(ctx, storageBox)
+	if value := args.Get(0); value != nil {
+		storageBoxSubaccounts = value.([]*hcloud.StorageBoxSubaccount)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllSubaccountsWithOpts implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) AllSubaccountsWithOpts(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxSubaccountListOpts) (storageBoxSubaccounts []*hcloud.StorageBoxSubaccount, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxSubaccounts = value.([]*hcloud.StorageBoxSubaccount)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) AllWithOpts(ctx context.Context, opts hcloud.StorageBoxListOpts) (storageBoxes []*hcloud.StorageBox, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxes = value.([]*hcloud.StorageBox)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeProtection implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) ChangeProtection(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeSubaccountHomeDirectory implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) ChangeSubaccountHomeDirectory(ctx context.Context, subaccount *hcloud.StorageBoxSubaccount, opts hcloud.StorageBoxSubaccountChangeHomeDirectoryOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, subaccount, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeType implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) ChangeType(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxChangeTypeOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) Create(ctx context.Context, opts hcloud.StorageBoxCreateOpts) (result hcloud.StorageBoxCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.StorageBoxCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// CreateSnapshot implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) CreateSnapshot(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxSnapshotCreateOpts) (result hcloud.StorageBoxSnapshotCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.StorageBoxSnapshotCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// CreateSubaccount implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) CreateSubaccount(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxSubaccountCreateOpts) (result hcloud.StorageBoxSubaccountCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.StorageBoxSubaccountCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) Delete(ctx context.Context, storageBox *hcloud.StorageBox) (result hcloud.StorageBoxDeleteResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.StorageBoxDeleteResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DeleteSnapshot implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) DeleteSnapshot(ctx context.Context, snapshot *hcloud.StorageBoxSnapshot) (result hcloud.StorageBoxSnapshotDeleteResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, snapshot)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.StorageBoxSnapshotDeleteResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DeleteSubaccount implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) DeleteSubaccount(ctx context.Context, subaccount *hcloud.StorageBoxSubaccount) (result hcloud.StorageBoxSubaccountDeleteResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, subaccount)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.StorageBoxSubaccountDeleteResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DisableSnapshotPlan implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) DisableSnapshotPlan(ctx context.Context, storageBox *hcloud.StorageBox) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// EnableSnapshotPlan implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) EnableSnapshotPlan(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxEnableSnapshotPlanOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Folders implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) Folders(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxFoldersOpts) (result hcloud.StorageBoxFoldersResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.StorageBoxFoldersResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) Get(ctx context.Context, idOrName string) (storageBox *hcloud.StorageBox, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		storageBox = value.(*hcloud.StorageBox)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) GetByID(ctx context.Context, id int64) (storageBox *hcloud.StorageBox, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		storageBox = value.(*hcloud.StorageBox)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) GetByName(ctx context.Context, name string) (storageBox *hcloud.StorageBox, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		storageBox = value.(*hcloud.StorageBox)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetSnapshot implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) GetSnapshot(ctx context.Context, storageBox *hcloud.StorageBox, idOrName string) (storageBoxSnapshot *hcloud.StorageBoxSnapshot, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, idOrName)
+	if value := args.Get(0); value != nil {
+		storageBoxSnapshot = value.(*hcloud.StorageBoxSnapshot)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetSnapshotByID implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) GetSnapshotByID(ctx context.Context, storageBox *hcloud.StorageBox, id int64) (storageBoxSnapshot *hcloud.StorageBoxSnapshot, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, id)
+	if value := args.Get(0); value != nil {
+		storageBoxSnapshot = value.(*hcloud.StorageBoxSnapshot)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetSnapshotByName implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) GetSnapshotByName(ctx context.Context, storageBox *hcloud.StorageBox, name string) (storageBoxSnapshot *hcloud.StorageBoxSnapshot, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, name)
+	if value := args.Get(0); value != nil {
+		storageBoxSnapshot = value.(*hcloud.StorageBoxSnapshot)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetSubaccount implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) GetSubaccount(ctx context.Context, storageBox *hcloud.StorageBox, idOrName string) (storageBoxSubaccount *hcloud.StorageBoxSubaccount, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, idOrName)
+	if value := args.Get(0); value != nil {
+		storageBoxSubaccount = value.(*hcloud.StorageBoxSubaccount)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetSubaccountByID implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) GetSubaccountByID(ctx context.Context, storageBox *hcloud.StorageBox, id int64) (storageBoxSubaccount *hcloud.StorageBoxSubaccount, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, id)
+	if value := args.Get(0); value != nil {
+		storageBoxSubaccount = value.(*hcloud.StorageBoxSubaccount)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetSubaccountByName implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) GetSubaccountByName(ctx context.Context, storageBox *hcloud.StorageBox, name string) (storageBoxSubaccount *hcloud.StorageBoxSubaccount, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, name)
+	if value := args.Get(0); value != nil {
+		storageBoxSubaccount = value.(*hcloud.StorageBoxSubaccount)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetSubaccountByUsername implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) GetSubaccountByUsername(ctx context.Context, storageBox *hcloud.StorageBox, username string) (storageBoxSubaccount *hcloud.StorageBoxSubaccount, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, username)
+	if value := args.Get(0); value != nil {
+		storageBoxSubaccount = value.(*hcloud.StorageBoxSubaccount)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) Iter(ctx context.Context, opts hcloud.StorageBoxListOpts) (storageBoxes iter.Seq2[*hcloud.StorageBox, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxes = value.(iter.Seq2[*hcloud.StorageBox, error])
+	}
+	return
+}
+
+// IterSnapshots implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) IterSnapshots(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxSnapshotListOpts) (storageBoxSnapshots iter.Seq2[*hcloud.StorageBoxSnapshot, error]) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxSnapshots = value.(iter.Seq2[*hcloud.StorageBoxSnapshot, error])
+	}
+	return
+}
+
+// IterSubaccounts implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) IterSubaccounts(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxSubaccountListOpts) (storageBoxSubaccounts iter.Seq2[*hcloud.StorageBoxSubaccount, error]) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxSubaccounts = value.(iter.Seq2[*hcloud.StorageBoxSubaccount, error])
+	}
+	return
+}
+
+// List implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) List(ctx context.Context, opts hcloud.StorageBoxListOpts) (storageBoxes []*hcloud.StorageBox, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxes = value.([]*hcloud.StorageBox)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ListSnapshots implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) ListSnapshots(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxSnapshotListOpts) (storageBoxSnapshots []*hcloud.StorageBoxSnapshot, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxSnapshots = value.([]*hcloud.StorageBoxSnapshot)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ListSubaccounts implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) ListSubaccounts(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxSubaccountListOpts) (storageBoxSubaccounts []*hcloud.StorageBoxSubaccount, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxSubaccounts = value.([]*hcloud.StorageBoxSubaccount)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ResetPassword implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) ResetPassword(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxResetPasswordOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ResetSubaccountPassword implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) ResetSubaccountPassword(ctx context.Context, subaccount *hcloud.StorageBoxSubaccount, opts hcloud.StorageBoxSubaccountResetPasswordOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, subaccount, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RollbackSnapshot implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) RollbackSnapshot(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxRollbackSnapshotOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) Update(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxUpdateOpts) (result *hcloud.StorageBox, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.StorageBox)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// UpdateAccessSettings implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) UpdateAccessSettings(ctx context.Context, storageBox *hcloud.StorageBox, opts hcloud.StorageBoxUpdateAccessSettingsOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, storageBox, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// UpdateSnapshot implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) UpdateSnapshot(ctx context.Context, snapshot *hcloud.StorageBoxSnapshot, opts hcloud.StorageBoxSnapshotUpdateOpts) (storageBoxSnapshot *hcloud.StorageBoxSnapshot, response *hcloud.Response, err error) {
+	args := m.Called(ctx, snapshot, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxSnapshot = value.(*hcloud.StorageBoxSnapshot)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// UpdateSubaccount implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) UpdateSubaccount(ctx context.Context, subaccount *hcloud.StorageBoxSubaccount, opts hcloud.StorageBoxSubaccountUpdateOpts) (storageBoxSubaccount *hcloud.StorageBoxSubaccount, response *hcloud.Response, err error) {
+	args := m.Called(ctx, subaccount, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxSubaccount = value.(*hcloud.StorageBoxSubaccount)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// UpdateSubaccountAccessSettings implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) UpdateSubaccountAccessSettings(ctx context.Context, subaccount *hcloud.StorageBoxSubaccount, opts hcloud.StorageBoxSubaccountUpdateAccessSettingsOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, subaccount, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// WaitUntil implements [hcloud.IStorageBoxClient].
+func (m *StorageBoxClient) WaitUntil(ctx context.Context, storageBox *hcloud.StorageBox, condition func(*hcloud.StorageBox) (bool, error)) (result *hcloud.StorageBox, err error) {
+	args := m.Called(ctx, storageBox, condition)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.StorageBox)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
@@ -0,0 +1,123 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// StorageBoxTypeClient is a mock implementation of [hcloud.IStorageBoxTypeClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type StorageBoxTypeClient struct {
+	mock.Mock
+}
+
+// NewStorageBoxTypeClient returns a new [StorageBoxTypeClient], whose expectations are asserted when the
+// test ends.
+func NewStorageBoxTypeClient(t TestingT) *StorageBoxTypeClient {
+	m := &StorageBoxTypeClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IStorageBoxTypeClient].
+func (m *StorageBoxTypeClient) All(ctx context.Context) (storageBoxTypes []*hcloud.StorageBoxType, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		storageBoxTypes = value.([]*hcloud.StorageBoxType)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IStorageBoxTypeClient].
+func (m *StorageBoxTypeClient) AllWithOpts(ctx context.Context, opts hcloud.StorageBoxTypeListOpts) (storageBoxTypes []*hcloud.StorageBoxType, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxTypes = value.([]*hcloud.StorageBoxType)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IStorageBoxTypeClient].
+func (m *StorageBoxTypeClient) Get(ctx context.Context, idOrName string) (storageBoxType *hcloud.StorageBoxType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		storageBoxType = value.(*hcloud.StorageBoxType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IStorageBoxTypeClient].
+func (m *StorageBoxTypeClient) GetByID(ctx context.Context, id int64) (storageBoxType *hcloud.StorageBoxType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		storageBoxType = value.(*hcloud.StorageBoxType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IStorageBoxTypeClient].
+func (m *StorageBoxTypeClient) GetByName(ctx context.Context, name string) (storageBoxType *hcloud.StorageBoxType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		storageBoxType = value.(*hcloud.StorageBoxType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IStorageBoxTypeClient].
+func (m *StorageBoxTypeClient) Iter(ctx context.Context, opts hcloud.StorageBoxTypeListOpts) (storageBoxTypes iter.Seq2[*hcloud.StorageBoxType, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxTypes = value.(iter.Seq2[*hcloud.StorageBoxType, error])
+	}
+	return
+}
+
+// List implements [hcloud.IStorageBoxTypeClient].
+func (m *StorageBoxTypeClient) List(ctx context.Context, opts hcloud.StorageBoxTypeListOpts) (storageBoxTypes []*hcloud.StorageBoxType, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		storageBoxTypes = value.([]*hcloud.StorageBoxType)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
@@ -0,0 +1,240 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// VolumeClient is a mock implementation of [hcloud.IVolumeClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type VolumeClient struct {
+	mock.Mock
+}
+
+// NewVolumeClient returns a new [VolumeClient], whose expectations are asserted when the
+// test ends.
+func NewVolumeClient(t TestingT) *VolumeClient {
+	m := &VolumeClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// All implements [hcloud.IVolumeClient].
+func (m *VolumeClient) All(ctx context.Context) (volumes []*hcloud.Volume, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		volumes = value.([]*hcloud.Volume)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IVolumeClient].
+func (m *VolumeClient) AllWithOpts(ctx context.Context, opts hcloud.VolumeListOpts) (volumes []*hcloud.Volume, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		volumes = value.([]*hcloud.Volume)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Attach implements [hcloud.IVolumeClient].
+func (m *VolumeClient) Attach(ctx context.Context, volume *hcloud.Volume, server *hcloud.Server) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, volume, server)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AttachWithOpts implements [hcloud.IVolumeClient].
+func (m *VolumeClient) AttachWithOpts(ctx context.Context, volume *hcloud.Volume, opts hcloud.VolumeAttachOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, volume, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeProtection implements [hcloud.IVolumeClient].
+func (m *VolumeClient) ChangeProtection(ctx context.Context, volume *hcloud.Volume, opts hcloud.VolumeChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, volume, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.IVolumeClient].
+func (m *VolumeClient) Create(ctx context.Context, opts hcloud.VolumeCreateOpts) (result hcloud.VolumeCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.VolumeCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.IVolumeClient].
+func (m *VolumeClient) Delete(ctx context.Context, volume *hcloud.Volume) (response *hcloud.Response, err error) {
+	args := m.Called(ctx, volume)
+	if value := args.Get(0); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Detach implements [hcloud.IVolumeClient].
+func (m *VolumeClient) Detach(ctx context.Context, volume *hcloud.Volume) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, volume)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IVolumeClient].
+func (m *VolumeClient) Get(ctx context.Context, idOrName string) (volume *hcloud.Volume, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		volume = value.(*hcloud.Volume)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IVolumeClient].
+func (m *VolumeClient) GetByID(ctx context.Context, id int64) (volume *hcloud.Volume, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		volume = value.(*hcloud.Volume)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IVolumeClient].
+func (m *VolumeClient) GetByName(ctx context.Context, name string) (volume *hcloud.Volume, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		volume = value.(*hcloud.Volume)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IVolumeClient].
+func (m *VolumeClient) Iter(ctx context.Context, opts hcloud.VolumeListOpts) (volumes iter.Seq2[*hcloud.Volume, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		volumes = value.(iter.Seq2[*hcloud.Volume, error])
+	}
+	return
+}
+
+// List implements [hcloud.IVolumeClient].
+func (m *VolumeClient) List(ctx context.Context, opts hcloud.VolumeListOpts) (volumes []*hcloud.Volume, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		volumes = value.([]*hcloud.Volume)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Resize implements [hcloud.IVolumeClient].
+func (m *VolumeClient) Resize(ctx context.Context, volume *hcloud.Volume, size int) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, volume, size)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.IVolumeClient].
+func (m *VolumeClient) Update(ctx context.Context, volume *hcloud.Volume, opts hcloud.VolumeUpdateOpts) (result *hcloud.Volume, response *hcloud.Response, err error) {
+	args := m.Called(ctx, volume, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Volume)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
@@ -0,0 +1,468 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../mock.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/stretchr/testify/mock"
+)
+
+// ZoneClient is a mock implementation of [hcloud.IZoneClient], based on [mock.Mock].
+//
+// The expectations are set using [mock.Mock.On], the variadic arguments are matched as
+// a single slice argument.
+type ZoneClient struct {
+	mock.Mock
+}
+
+// NewZoneClient returns a new [ZoneClient], whose expectations are asserted when the
+// test ends.
+func NewZoneClient(t TestingT) *ZoneClient {
+	m := &ZoneClient{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// AddRRSetRecords implements [hcloud.IZoneClient].
+func (m *ZoneClient) AddRRSetRecords(ctx context.Context, rrset *hcloud.ZoneRRSet, opts hcloud.ZoneRRSetAddRecordsOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, rrset, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// All implements [hcloud.IZoneClient].
+func (m *ZoneClient) All(ctx context.Context) (zones []*hcloud.Zone, err error) {
+	args := m.Called(ctx)
+	if value := args.Get(0); value != nil {
+		zones = value.([]*hcloud.Zone)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllRRSets implements [hcloud.IZoneClient].
+func (m *ZoneClient) AllRRSets(ctx context.Context, zone *hcloud.Zone) (zoneRRSets []*hcloud.ZoneRRSet, err error) {
+	args := m.Called(ctx, zone)
+	if value := args.Get(0); value != nil {
+		zoneRRSets = value.([]*hcloud.ZoneRRSet)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllRRSetsWithOpts implements [hcloud.IZoneClient].
+func (m *ZoneClient) AllRRSetsWithOpts(ctx context.Context, zone *hcloud.Zone, opts hcloud.ZoneRRSetListOpts) (zoneRRSets []*hcloud.ZoneRRSet, err error) {
+	args := m.Called(ctx, zone, opts)
+	if value := args.Get(0); value != nil {
+		zoneRRSets = value.([]*hcloud.ZoneRRSet)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// AllWithOpts implements [hcloud.IZoneClient].
+func (m *ZoneClient) AllWithOpts(ctx context.Context, opts hcloud.ZoneListOpts) (zones []*hcloud.Zone, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		zones = value.([]*hcloud.Zone)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangePrimaryNameservers implements [hcloud.IZoneClient].
+func (m *ZoneClient) ChangePrimaryNameservers(ctx context.Context, zone *hcloud.Zone, opts hcloud.ZoneChangePrimaryNameserversOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeProtection implements [hcloud.IZoneClient].
+func (m *ZoneClient) ChangeProtection(ctx context.Context, zone *hcloud.Zone, opts hcloud.ZoneChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeRRSetProtection implements [hcloud.IZoneClient].
+func (m *ZoneClient) ChangeRRSetProtection(ctx context.Context, rrset *hcloud.ZoneRRSet, opts hcloud.ZoneRRSetChangeProtectionOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, rrset, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeRRSetTTL implements [hcloud.IZoneClient].
+func (m *ZoneClient) ChangeRRSetTTL(ctx context.Context, rrset *hcloud.ZoneRRSet, opts hcloud.ZoneRRSetChangeTTLOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, rrset, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ChangeTTL implements [hcloud.IZoneClient].
+func (m *ZoneClient) ChangeTTL(ctx context.Context, zone *hcloud.Zone, opts hcloud.ZoneChangeTTLOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Create implements [hcloud.IZoneClient].
+func (m *ZoneClient) Create(ctx context.Context, opts hcloud.ZoneCreateOpts) (result hcloud.ZoneCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ZoneCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// CreateRRSet implements [hcloud.IZoneClient].
+func (m *ZoneClient) CreateRRSet(ctx context.Context, zone *hcloud.Zone, opts hcloud.ZoneRRSetCreateOpts) (result hcloud.ZoneRRSetCreateResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ZoneRRSetCreateResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Delete implements [hcloud.IZoneClient].
+func (m *ZoneClient) Delete(ctx context.Context, zone *hcloud.Zone) (result hcloud.ZoneDeleteResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ZoneDeleteResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// DeleteRRSet implements [hcloud.IZoneClient].
+func (m *ZoneClient) DeleteRRSet(ctx context.Context, rrset *hcloud.ZoneRRSet) (result hcloud.ZoneRRSetDeleteResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, rrset)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ZoneRRSetDeleteResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ExportZonefile implements [hcloud.IZoneClient].
+func (m *ZoneClient) ExportZonefile(ctx context.Context, zone *hcloud.Zone) (result hcloud.ZoneExportZonefileResult, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone)
+	if value := args.Get(0); value != nil {
+		result = value.(hcloud.ZoneExportZonefileResult)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Get implements [hcloud.IZoneClient].
+func (m *ZoneClient) Get(ctx context.Context, idOrName string) (zone *hcloud.Zone, response *hcloud.Response, err error) {
+	args := m.Called(ctx, idOrName)
+	if value := args.Get(0); value != nil {
+		zone = value.(*hcloud.Zone)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByID implements [hcloud.IZoneClient].
+func (m *ZoneClient) GetByID(ctx context.Context, id int64) (zone *hcloud.Zone, response *hcloud.Response, err error) {
+	args := m.Called(ctx, id)
+	if value := args.Get(0); value != nil {
+		zone = value.(*hcloud.Zone)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetByName implements [hcloud.IZoneClient].
+func (m *ZoneClient) GetByName(ctx context.Context, name string) (zone *hcloud.Zone, response *hcloud.Response, err error) {
+	args := m.Called(ctx, name)
+	if value := args.Get(0); value != nil {
+		zone = value.(*hcloud.Zone)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetRRSetByID implements [hcloud.IZoneClient].
+func (m *ZoneClient) GetRRSetByID(ctx context.Context, zone *hcloud.Zone, rrsetID string) (zoneRRSet *hcloud.ZoneRRSet, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone, rrsetID)
+	if value := args.Get(0); value != nil {
+		zoneRRSet = value.(*hcloud.ZoneRRSet)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// GetRRSetByNameAndType implements [hcloud.IZoneClient].
+func (m *ZoneClient) GetRRSetByNameAndType(ctx context.Context, zone *hcloud.Zone, rrsetName string, rrsetType hcloud.ZoneRRSetType) (zoneRRSet *hcloud.ZoneRRSet, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone, rrsetName, rrsetType)
+	if value := args.Get(0); value != nil {
+		zoneRRSet = value.(*hcloud.ZoneRRSet)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ImportZonefile implements [hcloud.IZoneClient].
+func (m *ZoneClient) ImportZonefile(ctx context.Context, zone *hcloud.Zone, opts hcloud.ZoneImportZonefileOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Iter implements [hcloud.IZoneClient].
+func (m *ZoneClient) Iter(ctx context.Context, opts hcloud.ZoneListOpts) (zones iter.Seq2[*hcloud.Zone, error]) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		zones = value.(iter.Seq2[*hcloud.Zone, error])
+	}
+	return
+}
+
+// IterRRSets implements [hcloud.IZoneClient].
+func (m *ZoneClient) IterRRSets(ctx context.Context, zone *hcloud.Zone, opts hcloud.ZoneRRSetListOpts) (zoneRRSets iter.Seq2[*hcloud.ZoneRRSet, error]) {
+	args := m.Called(ctx, zone, opts)
+	if value := args.Get(0); value != nil {
+		zoneRRSets = value.(iter.Seq2[*hcloud.ZoneRRSet, error])
+	}
+	return
+}
+
+// List implements [hcloud.IZoneClient].
+func (m *ZoneClient) List(ctx context.Context, opts hcloud.ZoneListOpts) (zones []*hcloud.Zone, response *hcloud.Response, err error) {
+	args := m.Called(ctx, opts)
+	if value := args.Get(0); value != nil {
+		zones = value.([]*hcloud.Zone)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// ListRRSets implements [hcloud.IZoneClient].
+func (m *ZoneClient) ListRRSets(ctx context.Context, zone *hcloud.Zone, opts hcloud.ZoneRRSetListOpts) (zoneRRSets []*hcloud.ZoneRRSet, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone, opts)
+	if value := args.Get(0); value != nil {
+		zoneRRSets = value.([]*hcloud.ZoneRRSet)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// RemoveRRSetRecords implements [hcloud.IZoneClient].
+func (m *ZoneClient) RemoveRRSetRecords(ctx context.Context, rrset *hcloud.ZoneRRSet, opts hcloud.ZoneRRSetRemoveRecordsOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, rrset, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// SetRRSetRecords implements [hcloud.IZoneClient].
+func (m *ZoneClient) SetRRSetRecords(ctx context.Context, rrset *hcloud.ZoneRRSet, opts hcloud.ZoneRRSetSetRecordsOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, rrset, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// Update implements [hcloud.IZoneClient].
+func (m *ZoneClient) Update(ctx context.Context, zone *hcloud.Zone, opts hcloud.ZoneUpdateOpts) (result *hcloud.Zone, response *hcloud.Response, err error) {
+	args := m.Called(ctx, zone, opts)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Zone)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// UpdateRRSet implements [hcloud.IZoneClient].
+func (m *ZoneClient) UpdateRRSet(ctx context.Context, rrset *hcloud.ZoneRRSet, opts hcloud.ZoneRRSetUpdateOpts) (zoneRRSet *hcloud.ZoneRRSet, response *hcloud.Response, err error) {
+	args := m.Called(ctx, rrset, opts)
+	if value := args.Get(0); value != nil {
+		zoneRRSet = value.(*hcloud.ZoneRRSet)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// UpdateRRSetRecords implements [hcloud.IZoneClient].
+func (m *ZoneClient) UpdateRRSetRecords(ctx context.Context, rrset *hcloud.ZoneRRSet, opts hcloud.ZoneRRSetUpdateRecordsOpts) (action *hcloud.Action, response *hcloud.Response, err error) {
+	args := m.Called(ctx, rrset, opts)
+	if value := args.Get(0); value != nil {
+		action = value.(*hcloud.Action)
+	}
+	if value := args.Get(1); value != nil {
+		response = value.(*hcloud.Response)
+	}
+	if value := args.Get(2); value != nil {
+		err = value.(error)
+	}
+	return
+}
+
+// WaitUntil implements [hcloud.IZoneClient].
+func (m *ZoneClient) WaitUntil(ctx context.Context, zone *hcloud.Zone, condition func(*hcloud.Zone) (bool, error)) (result *hcloud.Zone, err error) {
+	args := m.Called(ctx, zone, condition)
+	if value := args.Get(0); value != nil {
+		result = value.(*hcloud.Zone)
+	}
+	if value := args.Get(1); value != nil {
+		err = value.(error)
+	}
+	return
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"strconv"
@@ -167,7 +168,20 @@ func (c *NetworkClient) AllWithOpts(ctx context.Context, opts NetworkListOpts) (
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Network, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Network, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all networks for the given options.
+// The pages are fetched lazily while iterating.
+func (c *NetworkClient) Iter(ctx context.Context, opts NetworkListOpts) iter.Seq2[*Network, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Network, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
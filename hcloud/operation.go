@@ -0,0 +1,167 @@
+package hcloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// OperationResource is the set of resources supported by [Operation].
+type OperationResource interface {
+	Certificate | Firewall | FloatingIP | Image | LoadBalancer | Network |
+		PlacementGroup | PrimaryIP | Server | StorageBox | Volume | Zone
+}
+
+// Operation is a pending operation on a resource, e.g. the creation of a server. It
+// holds the resource as returned by the API, and the actions that must complete before
+// the resource is ready.
+type Operation[T OperationResource] struct {
+	Resource *T
+	Actions  []*Action
+
+	client *Client
+}
+
+// NewOperation returns an [Operation] that waits for the action and the next actions of
+// the resource. Nil actions are ignored.
+//
+// For example, to create a server and wait until it is ready:
+//
+//	result, _, err := client.Server.Create(ctx, opts)
+//	if err != nil {
+//		return err
+//	}
+//	server, err := hcloud.NewOperation(client, result.Server, result.Action, result.NextActions...).Wait(ctx)
+func NewOperation[T OperationResource](client *Client, resource *T, action *Action, nextActions ...*Action) *Operation[T] {
+	actions := make([]*Action, 0, 1+len(nextActions))
+	actions = append(actions, action)
+	actions = append(actions, nextActions...)
+
+	return &Operation[T]{
+		Resource: resource,
+		Actions:  slices.DeleteFunc(actions, func(a *Action) bool { return a == nil }),
+		client:   client,
+	}
+}
+
+// Wait waits until all the actions of the operation succeed, and returns the resource
+// fetched again from the API.
+//
+// If an action fails, the function stops waiting and returns the [ActionError] of the
+// failed action, wrapped with the command and the resources of the action.
+func (o *Operation[T]) Wait(ctx context.Context) (*T, error) {
+	return o.WaitFunc(ctx, nil)
+}
+
+// WaitFunc is like [Operation.Wait], and calls the handleProgress callback every time
+// an action is updated, with the overall progress of the actions in percent.
+func (o *Operation[T]) WaitFunc(ctx context.Context, handleProgress func(progress int, update *Action)) (*T, error) {
+	progress := make(map[int64]int, len(o.Actions))
+	for _, action := range o.Actions {
+		progress[action.ID] = action.Progress
+	}
+
+	err := o.client.Action.WaitForFunc(ctx, func(update *Action) error {
+		switch update.Status {
+		case ActionStatusRunning:
+			progress[update.ID] = update.Progress
+		case ActionStatusSuccess:
+			progress[update.ID] = 100
+		case ActionStatusError:
+			return fmt.Errorf("%s failed on %s: %w", update.Command, formatActionResources(update.Resources), update.Error())
+		}
+
+		if handleProgress != nil {
+			handleProgress(overallProgress(progress), update)
+		}
+		return nil
+	}, o.Actions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.refresh(ctx)
+}
+
+// refresh fetches the resource from the API.
+func (o *Operation[T]) refresh(ctx context.Context) (*T, error) {
+	var (
+		ref       ActionResource
+		refreshed any
+		err       error
+	)
+
+	switch r := any(o.Resource).(type) {
+	case *Certificate:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeCertificate}
+		refreshed, _, err = o.client.Certificate.GetByID(ctx, r.ID)
+	case *Firewall:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeFirewall}
+		refreshed, _, err = o.client.Firewall.GetByID(ctx, r.ID)
+	case *FloatingIP:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeFloatingIP}
+		refreshed, _, err = o.client.FloatingIP.GetByID(ctx, r.ID)
+	case *Image:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeImage}
+		refreshed, _, err = o.client.Image.GetByID(ctx, r.ID)
+	case *LoadBalancer:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeLoadBalancer}
+		refreshed, _, err = o.client.LoadBalancer.GetByID(ctx, r.ID)
+	case *Network:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeNetwork}
+		refreshed, _, err = o.client.Network.GetByID(ctx, r.ID)
+	case *PlacementGroup:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypePlacementGroup}
+		refreshed, _, err = o.client.PlacementGroup.GetByID(ctx, r.ID)
+	case *PrimaryIP:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypePrimaryIP}
+		refreshed, _, err = o.client.PrimaryIP.GetByID(ctx, r.ID)
+	case *Server:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeServer}
+		refreshed, _, err = o.client.Server.GetByID(ctx, r.ID)
+	case *StorageBox:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeStorageBox}
+		refreshed, _, err = o.client.StorageBox.GetByID(ctx, r.ID)
+	case *Volume:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeVolume}
+		refreshed, _, err = o.client.Volume.GetByID(ctx, r.ID)
+	case *Zone:
+		ref = ActionResource{ID: r.ID, Type: ActionResourceTypeZone}
+		refreshed, _, err = o.client.Zone.GetByID(ctx, r.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, _ := refreshed.(*T)
+	if result == nil {
+		return nil, fmt.Errorf("%s not found", formatActionResources([]*ActionResource{&ref}))
+	}
+	return result, nil
+}
+
+// overallProgress returns the average progress of the actions.
+func overallProgress(progress map[int64]int) int {
+	if len(progress) == 0 {
+		return 100
+	}
+	sum := 0
+	for _, p := range progress {
+		sum += p
+	}
+	return sum / len(progress)
+}
+
+// formatActionResources returns a human readable list of the resources, e.g.
+// "server 42, volume 7".
+func formatActionResources(resources []*ActionResource) string {
+	if len(resources) == 0 {
+		return "no resource"
+	}
+	parts := make([]string, 0, len(resources))
+	for _, r := range resources {
+		parts = append(parts, fmt.Sprintf("%s %d", r.Type, r.ID))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,127 @@
+package hcloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/mockutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func TestOperation(t *testing.T) {
+	t.Run("succeed", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/actions?id=1&id=2&page=1&sort=status&sort=id",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{ID: 1, Status: "success", Progress: 100},
+						{ID: 2, Status: "running", Progress: 40},
+					},
+				},
+			},
+			{
+				Method: "GET", Path: "/actions?id=2&page=1&sort=status&sort=id",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{ID: 2, Status: "success", Progress: 100},
+					},
+				},
+			},
+			{
+				Method: "GET", Path: "/servers/42",
+				Status: 200,
+				JSON: schema.ServerGetResponse{
+					Server: schema.Server{ID: 42, Status: "running"},
+				},
+			},
+		})
+
+		result := ServerCreateResult{
+			Server:      &Server{ID: 42, Status: ServerStatusInitializing},
+			Action:      &Action{ID: 1, Status: ActionStatusRunning},
+			NextActions: []*Action{{ID: 2, Status: ActionStatusRunning}},
+		}
+
+		progress := make([]int, 0)
+		op := NewOperation(client, result.Server, result.Action, result.NextActions...)
+		require.Len(t, op.Actions, 2)
+
+		srv, err := op.WaitFunc(ctx, func(p int, _ *Action) {
+			progress = append(progress, p)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), srv.ID)
+		assert.Equal(t, ServerStatusRunning, srv.Status)
+		assert.Equal(t, []int{50, 70, 100}, progress)
+	})
+
+	t.Run("no actions", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/firewalls/7",
+				Status: 200,
+				JSON:   schema.FirewallGetResponse{Firewall: schema.Firewall{ID: 7}},
+			},
+		})
+
+		firewall, err := NewOperation(client, &Firewall{ID: 7}, nil).Wait(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), firewall.ID)
+	})
+
+	t.Run("action failed", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/actions?id=1&page=1&sort=status&sort=id",
+				Status: 200,
+				JSON: schema.ActionListResponse{
+					Actions: []schema.Action{
+						{
+							ID:      1,
+							Command: "attach_volume",
+							Status:  "error",
+							Error:   &schema.ActionError{Code: "action_failed", Message: "Action failed"},
+							Resources: []schema.ActionResourceReference{
+								{ID: 42, Type: "server"},
+								{ID: 7, Type: "volume"},
+							},
+						},
+					},
+				},
+			},
+		})
+
+		_, err := NewOperation(client, &Volume{ID: 7}, &Action{ID: 1, Status: ActionStatusRunning}).Wait(ctx)
+		require.EqualError(t, err, "attach_volume failed on server 42, volume 7: Action failed (action_failed, 1)")
+
+		actionErr := ActionError{}
+		require.ErrorAs(t, err, &actionErr)
+		assert.Equal(t, int64(1), actionErr.Action().ID)
+	})
+
+	t.Run("resource not found", func(t *testing.T) {
+		ctx, server, client := makeTestUtils(t)
+
+		server.Expect([]mockutil.Request{
+			{
+				Method: "GET", Path: "/zones/3",
+				Status: 404,
+				JSON:   schema.ErrorResponse{Error: schema.Error{Code: string(ErrorCodeNotFound)}},
+			},
+		})
+
+		_, err := NewOperation(client, &Zone{ID: 3}, &Action{ID: 1, Status: ActionStatusSuccess}).Wait(ctx)
+		require.EqualError(t, err, "zone 3 not found")
+	})
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -114,7 +115,20 @@ func (c *PlacementGroupClient) AllWithOpts(ctx context.Context, opts PlacementGr
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*PlacementGroup, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*PlacementGroup, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all PlacementGroups for the given options.
+// The pages are fetched lazily while iterating.
+func (c *PlacementGroupClient) Iter(ctx context.Context, opts PlacementGroupListOpts) iter.Seq2[*PlacementGroup, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*PlacementGroup, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -140,6 +140,15 @@ type PricingClient struct {
 
 // Get retrieves pricing information.
 func (c *PricingClient) Get(ctx context.Context) (Pricing, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		pricing, err := cache.pricing.get(ctx)
+		return pricing, nil, err
+	}
+
+	return c.fetch(ctx)
+}
+
+func (c *PricingClient) fetch(ctx context.Context) (Pricing, *Response, error) {
 	const opPath = "/pricing"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
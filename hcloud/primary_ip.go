@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"strconv"
@@ -252,7 +253,20 @@ func (c *PrimaryIPClient) AllWithOpts(ctx context.Context, opts PrimaryIPListOpt
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*PrimaryIP, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*PrimaryIP, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all Primary IPs for the given options.
+// The pages are fetched lazily while iterating.
+func (c *PrimaryIPClient) Iter(ctx context.Context, opts PrimaryIPListOpts) iter.Seq2[*PrimaryIP, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*PrimaryIP, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
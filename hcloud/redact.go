@@ -0,0 +1,14 @@
+package hcloud
+
+// WithRedactedFields configures a Client to redact the given JSON fields from the
+// request and response bodies written by [WithDebugWriter] or [WithLoggerOpts], in
+// addition to the sensitive fields known by the library (e.g. passwords, private keys).
+//
+// A field is described by a dot separated path from the root of the JSON body (e.g.
+// "server.labels.secret"). The "*" path segment matches any field, and arrays are
+// traversed transparently (e.g. "zones.primary_nameservers.tsig_key").
+func WithRedactedFields(paths ...string) ClientOption {
+	return func(client *Client) {
+		client.redactedFields = append(client.redactedFields, paths...)
+	}
+}
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/url"
 	"slices"
@@ -289,7 +290,20 @@ func (c *ServerClient) AllWithOpts(ctx context.Context, opts ServerListOpts) ([]
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Server, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Server, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all servers for the given options.
+// The pages are fetched lazily while iterating.
+func (c *ServerClient) Iter(ctx context.Context, opts ServerListOpts) iter.Seq2[*Server, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Server, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -348,7 +362,37 @@ type ServerCreateResult struct {
 }
 
 // Create creates a new server.
+//
+// See [WithIdempotentCreate] to prevent creating duplicate servers when retrying.
 func (c *ServerClient) Create(ctx context.Context, opts ServerCreateOpts) (ServerCreateResult, *Response, error) {
+	if err := opts.Validate(); err != nil {
+		return ServerCreateResult{}, nil, err
+	}
+	if !c.client.idempotentCreate {
+		return c.create(ctx, opts)
+	}
+
+	return idempotentCreate(ctx, opts.Labels,
+		func(ctx context.Context, labels map[string]string) (ServerCreateResult, *Response, error) {
+			opts := opts
+			opts.Labels = labels
+			return c.create(ctx, opts)
+		},
+		func(ctx context.Context, labelSelector string) (ServerCreateResult, bool, error) {
+			servers, _, err := c.List(ctx, ServerListOpts{ListOpts: ListOpts{LabelSelector: labelSelector}})
+			if err != nil || len(servers) == 0 {
+				return ServerCreateResult{}, false, err
+			}
+			actions, err := c.Action.AllFor(ctx, servers[0], ActionListOpts{})
+			if err != nil {
+				return ServerCreateResult{}, false, err
+			}
+			return ServerCreateResult{Server: servers[0], Action: findCreateAction(actions, "create_server")}, true, nil
+		},
+	)
+}
+
+func (c *ServerClient) create(ctx context.Context, opts ServerCreateOpts) (ServerCreateResult, *Response, error) {
 	const opPath = "/servers"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
@@ -356,10 +400,6 @@ func (c *ServerClient) Create(ctx context.Context, opts ServerCreateOpts) (Serve
 
 	reqPath := opPath
 
-	if err := opts.Validate(); err != nil {
-		return result, nil, err
-	}
-
 	var reqBody schema.ServerCreateRequest
 	reqBody.UserData = opts.UserData
 	reqBody.Name = opts.Name
@@ -1125,3 +1165,21 @@ func (c *ServerClient) RemoveFromPlacementGroup(ctx context.Context, server *Ser
 
 	return ActionFromSchema(respBody.Action), resp, nil
 }
+
+// WaitUntil fetches the server at the interval defined by [WithPollOpts], until the
+// condition holds (e.g. [ServerIsRunning]), and returns the latest state of the server.
+//
+// The function stops waiting when the condition returns an error, when the server does
+// not exist, or when the context is done.
+func (c *ServerClient) WaitUntil(ctx context.Context, server *Server, condition func(*Server) (bool, error)) (*Server, error) {
+	return waitUntil(ctx, c.client, fmt.Sprintf("server %d", server.ID),
+		func(ctx context.Context) (*Server, *Response, error) { return c.GetByID(ctx, server.ID) },
+		condition,
+	)
+}
+
+// ServerIsRunning is a [ServerClient.WaitUntil] condition which holds when the server is
+// running.
+func ServerIsRunning(server *Server) (bool, error) {
+	return server.Status == ServerStatusRunning, nil
+}
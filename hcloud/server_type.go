@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 
@@ -71,6 +72,10 @@ type ServerTypeClient struct {
 
 // GetByID retrieves a server type by its ID. If the server type does not exist, nil is returned.
 func (c *ServerTypeClient) GetByID(ctx context.Context, id int64) (*ServerType, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.serverTypes.find(ctx, func(s *ServerType) bool { return s.ID == id })
+	}
+
 	const opPath = "/server_types/%d"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
@@ -89,6 +94,10 @@ func (c *ServerTypeClient) GetByID(ctx context.Context, id int64) (*ServerType,
 
 // GetByName retrieves a server type by its name. If the server type does not exist, nil is returned.
 func (c *ServerTypeClient) GetByName(ctx context.Context, name string) (*ServerType, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil && name != "" {
+		return cache.serverTypes.find(ctx, func(s *ServerType) bool { return s.Name == name })
+	}
+
 	return firstByName(name, func() ([]*ServerType, *Response, error) {
 		return c.List(ctx, ServerTypeListOpts{Name: name})
 	})
@@ -141,6 +150,10 @@ func (c *ServerTypeClient) List(ctx context.Context, opts ServerTypeListOpts) ([
 
 // All returns all server types.
 func (c *ServerTypeClient) All(ctx context.Context) ([]*ServerType, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.serverTypes.all(ctx)
+	}
+
 	return c.AllWithOpts(ctx, ServerTypeListOpts{})
 }
 
@@ -149,7 +162,20 @@ func (c *ServerTypeClient) AllWithOpts(ctx context.Context, opts ServerTypeListO
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*ServerType, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*ServerType, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all server types for the given options.
+// The pages are fetched lazily while iterating.
+func (c *ServerTypeClient) Iter(ctx context.Context, opts ServerTypeListOpts) iter.Seq2[*ServerType, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*ServerType, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -113,7 +114,20 @@ func (c *SSHKeyClient) AllWithOpts(ctx context.Context, opts SSHKeyListOpts) ([]
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*SSHKey, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*SSHKey, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all SSH keys with the given options.
+// The pages are fetched lazily while iterating.
+func (c *SSHKeyClient) Iter(ctx context.Context, opts SSHKeyListOpts) iter.Seq2[*SSHKey, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*SSHKey, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
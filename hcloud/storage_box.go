@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -186,7 +187,22 @@ func (c *StorageBoxClient) AllWithOpts(ctx context.Context, opts StorageBoxListO
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*StorageBox, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*StorageBox, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all [StorageBox] with the given options.
+// The pages are fetched lazily while iterating.
+//
+// See https://docs.hetzner.cloud/reference/hetzner#storage-boxes-list-storage-boxes
+func (c *StorageBoxClient) Iter(ctx context.Context, opts StorageBoxListOpts) iter.Seq2[*StorageBox, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*StorageBox, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -235,17 +251,43 @@ type StorageBoxCreateResult struct {
 // in the SSHKeys slice of [StorageBoxCreateOpts]. Only the PublicKey field
 // is sent to the API. They are not addressable by ID or name.
 //
+// See [WithIdempotentCreate] to prevent creating duplicate storage boxes when retrying.
+//
 // See https://docs.hetzner.cloud/reference/hetzner#storage-boxes-create-a-storage-box
 func (c *StorageBoxClient) Create(ctx context.Context, opts StorageBoxCreateOpts) (StorageBoxCreateResult, *Response, error) {
+	if err := opts.Validate(); err != nil {
+		return StorageBoxCreateResult{}, nil, err
+	}
+	if !c.client.idempotentCreate {
+		return c.create(ctx, opts)
+	}
+
+	return idempotentCreate(ctx, opts.Labels,
+		func(ctx context.Context, labels map[string]string) (StorageBoxCreateResult, *Response, error) {
+			opts := opts
+			opts.Labels = labels
+			return c.create(ctx, opts)
+		},
+		func(ctx context.Context, labelSelector string) (StorageBoxCreateResult, bool, error) {
+			storageBoxes, _, err := c.List(ctx, StorageBoxListOpts{ListOpts: ListOpts{LabelSelector: labelSelector}})
+			if err != nil || len(storageBoxes) == 0 {
+				return StorageBoxCreateResult{}, false, err
+			}
+			actions, err := c.Action.AllFor(ctx, storageBoxes[0], ActionListOpts{})
+			if err != nil {
+				return StorageBoxCreateResult{}, false, err
+			}
+			return StorageBoxCreateResult{StorageBox: storageBoxes[0], Action: findCreateAction(actions, "create_storage_box")}, true, nil
+		},
+	)
+}
+
+func (c *StorageBoxClient) create(ctx context.Context, opts StorageBoxCreateOpts) (StorageBoxCreateResult, *Response, error) {
 	const opPath = "/storage_boxes"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
 	result := StorageBoxCreateResult{}
 
-	if err := opts.Validate(); err != nil {
-		return result, nil, err
-	}
-
 	reqBody := SchemaFromStorageBoxCreateOpts(opts)
 
 	respBody, resp, err := postRequest[schema.StorageBoxCreateResponse](ctx, c.client, opPath, reqBody)
@@ -526,3 +568,22 @@ func (c *StorageBoxClient) DisableSnapshotPlan(
 
 	return ActionFromSchema(respBody.Action), resp, nil
 }
+
+// WaitUntil fetches the [StorageBox] at the interval defined by [WithPollOpts], until
+// the condition holds (e.g. [StorageBoxIsInitialized]), and returns the latest state of
+// the [StorageBox].
+//
+// The function stops waiting when the condition returns an error, when the [StorageBox]
+// does not exist, or when the context is done.
+func (c *StorageBoxClient) WaitUntil(ctx context.Context, storageBox *StorageBox, condition func(*StorageBox) (bool, error)) (*StorageBox, error) {
+	return waitUntil(ctx, c.client, fmt.Sprintf("storage box %d", storageBox.ID),
+		func(ctx context.Context) (*StorageBox, *Response, error) { return c.GetByID(ctx, storageBox.ID) },
+		condition,
+	)
+}
+
+// StorageBoxIsInitialized is a [StorageBoxClient.WaitUntil] condition which holds when
+// the [StorageBox] is no longer initializing.
+func StorageBoxIsInitialized(storageBox *StorageBox) (bool, error) {
+	return storageBox.Status != StorageBoxStatusInitializing, nil
+}
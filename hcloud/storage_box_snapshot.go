@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -157,6 +158,21 @@ func (c *StorageBoxClient) AllSnapshots(
 	return snapshots, err
 }
 
+// IterSnapshots returns an iterator over all [StorageBoxSnapshot] of a [StorageBox] with
+// the given options.
+//
+// See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-list-snapshots
+func (c *StorageBoxClient) IterSnapshots(
+	ctx context.Context,
+	storageBox *StorageBox,
+	opts StorageBoxSnapshotListOpts,
+) iter.Seq2[*StorageBoxSnapshot, error] {
+	// The snapshots are not paginated, all of them are returned in the first page.
+	return iterPagesSeq(ctx, func(_ int) ([]*StorageBoxSnapshot, *Response, error) {
+		return c.ListSnapshots(ctx, storageBox, opts)
+	})
+}
+
 // StorageBoxSnapshotCreateOpts specifies options for creating a [StorageBoxSnapshot].
 type StorageBoxSnapshotCreateOpts struct {
 	Description string
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 
@@ -181,6 +182,21 @@ func (c *StorageBoxClient) AllSubaccounts(
 	return subaccounts, err
 }
 
+// IterSubaccounts returns an iterator over all [StorageBoxSubaccount] of a [StorageBox]
+// with the given options.
+//
+// See https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccounts-list-subaccounts
+func (c *StorageBoxClient) IterSubaccounts(
+	ctx context.Context,
+	storageBox *StorageBox,
+	opts StorageBoxSubaccountListOpts,
+) iter.Seq2[*StorageBoxSubaccount, error] {
+	// The subaccounts are not paginated, all of them are returned in the first page.
+	return iterPagesSeq(ctx, func(_ int) ([]*StorageBoxSubaccount, *Response, error) {
+		return c.ListSubaccounts(ctx, storageBox, opts)
+	})
+}
+
 // StorageBoxSubaccountCreateOpts represents the options for creating a [StorageBoxSubaccount].
 type StorageBoxSubaccountCreateOpts struct {
 	Name           string
@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/ctxutil"
@@ -73,6 +74,10 @@ func (c *StorageBoxTypeClient) List(ctx context.Context, opts StorageBoxTypeList
 //
 // See https://docs.hetzner.cloud/reference/hetzner#storage-box-types-list-storage-box-types
 func (c *StorageBoxTypeClient) All(ctx context.Context) ([]*StorageBoxType, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.storageBoxTypes.all(ctx)
+	}
+
 	return c.AllWithOpts(ctx, StorageBoxTypeListOpts{})
 }
 
@@ -83,7 +88,22 @@ func (c *StorageBoxTypeClient) AllWithOpts(ctx context.Context, opts StorageBoxT
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*StorageBoxType, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*StorageBoxType, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all storage box types for the given options.
+// The pages are fetched lazily while iterating.
+//
+// See https://docs.hetzner.cloud/reference/hetzner#storage-box-types-list-storage-box-types
+func (c *StorageBoxTypeClient) Iter(ctx context.Context, opts StorageBoxTypeListOpts) iter.Seq2[*StorageBoxType, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*StorageBoxType, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -93,6 +113,10 @@ func (c *StorageBoxTypeClient) AllWithOpts(ctx context.Context, opts StorageBoxT
 //
 // See https://docs.hetzner.cloud/reference/hetzner#storage-box-types-get-a-storage-box-type
 func (c *StorageBoxTypeClient) GetByID(ctx context.Context, id int64) (*StorageBoxType, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil {
+		return cache.storageBoxTypes.find(ctx, func(s *StorageBoxType) bool { return s.ID == id })
+	}
+
 	const opPath = "/storage_box_types/%d"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
@@ -113,6 +137,10 @@ func (c *StorageBoxTypeClient) GetByID(ctx context.Context, id int64) (*StorageB
 //
 // See https://docs.hetzner.cloud/reference/hetzner#storage-box-types-get-a-storage-box-type
 func (c *StorageBoxTypeClient) GetByName(ctx context.Context, name string) (*StorageBoxType, *Response, error) {
+	if cache := c.client.catalogCache; cache != nil && name != "" {
+		return cache.storageBoxTypes.find(ctx, func(s *StorageBoxType) bool { return s.Name == name })
+	}
+
 	return firstByName(name, func() ([]*StorageBoxType, *Response, error) {
 		return c.List(ctx, StorageBoxTypeListOpts{Name: name})
 	})
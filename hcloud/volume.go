@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -141,7 +142,20 @@ func (c *VolumeClient) AllWithOpts(ctx context.Context, opts VolumeListOpts) ([]
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Volume, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Volume, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all volumes with the given options.
+// The pages are fetched lazily while iterating.
+func (c *VolumeClient) Iter(ctx context.Context, opts VolumeListOpts) iter.Seq2[*Volume, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Volume, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -186,7 +200,37 @@ type VolumeCreateResult struct {
 }
 
 // Create creates a new volume with the given options.
+//
+// See [WithIdempotentCreate] to prevent creating duplicate volumes when retrying.
 func (c *VolumeClient) Create(ctx context.Context, opts VolumeCreateOpts) (VolumeCreateResult, *Response, error) {
+	if err := opts.Validate(); err != nil {
+		return VolumeCreateResult{}, nil, err
+	}
+	if !c.client.idempotentCreate {
+		return c.create(ctx, opts)
+	}
+
+	return idempotentCreate(ctx, opts.Labels,
+		func(ctx context.Context, labels map[string]string) (VolumeCreateResult, *Response, error) {
+			opts := opts
+			opts.Labels = labels
+			return c.create(ctx, opts)
+		},
+		func(ctx context.Context, labelSelector string) (VolumeCreateResult, bool, error) {
+			volumes, _, err := c.List(ctx, VolumeListOpts{ListOpts: ListOpts{LabelSelector: labelSelector}})
+			if err != nil || len(volumes) == 0 {
+				return VolumeCreateResult{}, false, err
+			}
+			actions, err := c.Action.AllFor(ctx, volumes[0], ActionListOpts{})
+			if err != nil {
+				return VolumeCreateResult{}, false, err
+			}
+			return VolumeCreateResult{Volume: volumes[0], Action: findCreateAction(actions, "create_volume")}, true, nil
+		},
+	)
+}
+
+func (c *VolumeClient) create(ctx context.Context, opts VolumeCreateOpts) (VolumeCreateResult, *Response, error) {
 	const opPath = "/volumes"
 	ctx = ctxutil.SetOpPath(ctx, opPath)
 
@@ -194,9 +238,6 @@ func (c *VolumeClient) Create(ctx context.Context, opts VolumeCreateOpts) (Volum
 
 	reqPath := opPath
 
-	if err := opts.Validate(); err != nil {
-		return result, nil, err
-	}
 	reqBody := schema.VolumeCreateRequest{
 		Name:      opts.Name,
 		Size:      opts.Size,
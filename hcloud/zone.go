@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -211,7 +212,22 @@ func (c *ZoneClient) AllWithOpts(ctx context.Context, opts ZoneListOpts) ([]*Zon
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*Zone, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*Zone, *Response, error) {
+		opts := opts
+		opts.Page = page
+		return c.List(ctx, opts)
+	})
+}
+
+// Iter returns an iterator over all [Zone] with the given options.
+// The pages are fetched lazily while iterating.
+//
+// See https://docs.hetzner.cloud/reference/cloud#zones-list-zones
+func (c *ZoneClient) Iter(ctx context.Context, opts ZoneListOpts) iter.Seq2[*Zone, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*Zone, *Response, error) {
 		opts.Page = page
 		return c.List(ctx, opts)
 	})
@@ -494,3 +510,26 @@ func (c *ZoneClient) ChangePrimaryNameservers(ctx context.Context, zone *Zone, o
 
 	return ActionFromSchema(respBody.Action), resp, err
 }
+
+// WaitUntil fetches the zone at the interval defined by [WithPollOpts], until the
+// condition holds (e.g. [ZoneIsDelegated]), and returns the latest state of the zone.
+//
+// The function stops waiting when the condition returns an error, when the zone does not
+// exist, or when the context is done.
+func (c *ZoneClient) WaitUntil(ctx context.Context, zone *Zone, condition func(*Zone) (bool, error)) (*Zone, error) {
+	idOrName, err := zone.idOrName()
+	if err != nil {
+		return nil, err
+	}
+
+	return waitUntil(ctx, c.client, "zone "+idOrName,
+		func(ctx context.Context) (*Zone, *Response, error) { return c.Get(ctx, idOrName) },
+		condition,
+	)
+}
+
+// ZoneIsDelegated is a [ZoneClient.WaitUntil] condition which holds when the zone is
+// correctly delegated to the assigned authoritative nameservers.
+func ZoneIsDelegated(zone *Zone) (bool, error) {
+	return zone.AuthoritativeNameservers.DelegationStatus == ZoneDelegationStatusValid, nil
+}
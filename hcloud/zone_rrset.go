@@ -3,6 +3,7 @@ package hcloud
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 	"strings"
 
@@ -166,7 +167,8 @@ func (c *ZoneClient) AllRRSetsWithOpts(ctx context.Context, zone *Zone, opts Zon
 	if opts.ListOpts.PerPage == 0 {
 		opts.ListOpts.PerPage = 50
 	}
-	return iterPages(func(page int) ([]*ZoneRRSet, *Response, error) {
+	return iterPages(c.client.pageConcurrency, func(page int) ([]*ZoneRRSet, *Response, error) {
+		opts := opts
 		opts.Page = page
 		return c.ListRRSets(ctx, zone, opts)
 	})
@@ -179,6 +181,20 @@ func (c *ZoneClient) AllRRSets(ctx context.Context, zone *Zone) ([]*ZoneRRSet, e
 	return c.AllRRSetsWithOpts(ctx, zone, ZoneRRSetListOpts{})
 }
 
+// IterRRSets returns an iterator over all [ZoneRRSet] with the given options.
+// The pages are fetched lazily while iterating.
+//
+// See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-list-rrsets
+func (c *ZoneClient) IterRRSets(ctx context.Context, zone *Zone, opts ZoneRRSetListOpts) iter.Seq2[*ZoneRRSet, error] {
+	if opts.ListOpts.PerPage == 0 {
+		opts.ListOpts.PerPage = 50
+	}
+	return iterPagesSeq(ctx, func(page int) ([]*ZoneRRSet, *Response, error) {
+		opts.Page = page
+		return c.ListRRSets(ctx, zone, opts)
+	})
+}
+
 // ZoneRRSetCreateOpts defines options for creating a [ZoneRRSet].
 type ZoneRRSetCreateOpts struct {
 	Name    string
@@ -198,6 +198,38 @@ func TestZoneAllRRSetsWithOpts(t *testing.T) {
 	}, result)
 }
 
+func TestZoneIterRRSets(t *testing.T) {
+	ctx, server, client := makeTestUtils(t)
+
+	server.Expect([]mockutil.Request{
+		{
+			Method: "GET", Path: "/zones/example.com/rrsets?page=1&per_page=50&type=A",
+			Status: 200,
+			JSONRaw: `{
+				"rrsets": [
+					{ "zone": 42, "id": "www/A", "name": "www", "type": "A" },
+					{ "zone": 42, "id": "blog/A", "name": "blog", "type": "A" }
+				],
+				"meta": { "pagination": { "page": 1, "next_page": 2 }}
+			}`,
+		},
+	})
+
+	// Breaking out of the loop must not fetch the next page.
+	var result *ZoneRRSet
+	for rrset, err := range client.Zone.IterRRSets(ctx,
+		&Zone{Name: "example.com"},
+		ZoneRRSetListOpts{Type: []ZoneRRSetType{"A"}},
+	) {
+		require.NoError(t, err)
+		if rrset.Name == "blog" {
+			result = rrset
+			break
+		}
+	}
+	require.Equal(t, &ZoneRRSet{Zone: &Zone{ID: 42}, ID: "blog/A", Name: "blog", Type: "A"}, result)
+}
+
 func TestZoneAllRRSets(t *testing.T) {
 	ctx, server, client := makeTestUtils(t)
 
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IActionClient ...
@@ -23,6 +24,11 @@ type IActionClient interface {
 	//
 	// It is required to set [ActionListOpts.ID]. Any other fields set in the opts are ignored.
 	AllWithOpts(ctx context.Context, opts ActionListOpts) ([]*Action, error)
+	// Iter returns an iterator over all actions for the given options.
+	// The pages are fetched lazily while iterating.
+	//
+	// It is required to set [ActionListOpts.ID]. Any other fields set in the opts are ignored.
+	Iter(ctx context.Context, opts ActionListOpts) iter.Seq2[*Action, error]
 	// WatchOverallProgress watches several actions' progress until they complete
 	// with success or error. This watching happens in a goroutine and updates are
 	// provided through the two returned channels:
@@ -70,7 +76,7 @@ type IActionClient interface {
 	// either [ActionStatusSuccess] or [ActionStatusError].
 	//
 	// The handleUpdate callback is called every time an action is updated.
-	WaitForFunc(ctx context.Context, handleUpdate func(update *Action) error, actions ...*Action) error
+	WaitForFunc(ctx context.Context, handleUpdate func(update *Action) error, actions ...*Action) (err error)
 	// WaitFor waits until all actions succeed by polling the API at the interval defined by
 	// [WithPollOpts]. An action is considered as succeeded when its status is either
 	// [ActionStatusSuccess].
@@ -80,4 +86,30 @@ type IActionClient interface {
 	//
 	// For more flexibility, see the [ActionClient.WaitForFunc] function.
 	WaitFor(ctx context.Context, actions ...*Action) error
+	// Query returns an iterator over the actions matching the query. The pages are fetched
+	// lazily while iterating.
+	//
+	// The actions are returned from the most to the least recently started, and the
+	// iteration stops at the first action started before [ActionQuery.StartedAfter].
+	// The filters by command and time range are applied on the client side.
+	Query(ctx context.Context, query ActionQuery) iter.Seq2[*Action, error]
+	// Updates returns an iterator over the updates of the actions, until all actions are
+	// completed. The actions are polled using [ActionClient.WaitForFunc].
+	//
+	// Each action is yielded once when first seen, and then whenever its status or progress
+	// changes. A failed action does not stop the iteration, its error is available using
+	// [Action.Error] on the update. An error is only yielded when waiting for the actions
+	// failed, for example when the context is canceled, and ends the iteration.
+	Updates(ctx context.Context, actions ...*Action) iter.Seq2[ActionUpdate, error]
+	// WatchUpdates watches the updates of the actions in a goroutine, until all actions are
+	// completed. This is the channel based variant of [ActionClient.Updates]:
+	//
+	//   - The first channel receives the updates of the actions. Unlike
+	//     [ActionClient.WatchProgress], no update is dropped, the channel must therefore be
+	//     drained.
+	//   - The second channel receives the error that stopped the watch, if any.
+	//
+	// To stop watching, cancel the [context.Context]. Once the method has stopped watching,
+	// both returned channels are closed.
+	WatchUpdates(ctx context.Context, actions ...*Action) (<-chan ActionUpdate, <-chan error)
 }
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // ICertificateClient ...
@@ -24,6 +25,9 @@ type ICertificateClient interface {
 	All(ctx context.Context) ([]*Certificate, error)
 	// AllWithOpts returns all Certificates for the given options.
 	AllWithOpts(ctx context.Context, opts CertificateListOpts) ([]*Certificate, error)
+	// Iter returns an iterator over all Certificates for the given options.
+	// The pages are fetched lazily while iterating.
+	Iter(ctx context.Context, opts CertificateListOpts) iter.Seq2[*Certificate, error]
 	// Create creates a new uploaded certificate.
 	//
 	// Create returns an error for certificates of any other type. Use
@@ -37,4 +41,11 @@ type ICertificateClient interface {
 	Delete(ctx context.Context, certificate *Certificate) (*Response, error)
 	// RetryIssuance retries the issuance of a failed managed certificate.
 	RetryIssuance(ctx context.Context, certificate *Certificate) (*Action, *Response, error)
+	// WaitUntil fetches the certificate at the interval defined by [WithPollOpts], until the
+	// condition holds (e.g. [CertificateIsIssued]), and returns the latest state of the
+	// certificate.
+	//
+	// The function stops waiting when the condition returns an error, when the certificate
+	// does not exist, or when the context is done.
+	WaitUntil(ctx context.Context, certificate *Certificate, condition func(*Certificate) (bool, error)) (*Certificate, error)
 }
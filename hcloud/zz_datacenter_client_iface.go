@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IDatacenterClient ...
@@ -42,4 +43,10 @@ type IDatacenterClient interface {
 	// Deprecated: [DatacenterClient.AllWithOpts] is deprecated and will be removed after the 2026-10-01. See
 	// https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
 	AllWithOpts(ctx context.Context, opts DatacenterListOpts) ([]*Datacenter, error)
+	// Iter returns an iterator over all datacenters for the given options.
+	// The pages are fetched lazily while iterating.
+	//
+	// Deprecated: [DatacenterClient.Iter] is deprecated and will be removed after the 2026-10-01. See
+	// https://docs.hetzner.cloud/changelog#2026-06-02-datacenters-deprecated.
+	Iter(ctx context.Context, opts DatacenterListOpts) iter.Seq2[*Datacenter, error]
 }
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IFirewallClient ...
@@ -24,6 +25,9 @@ type IFirewallClient interface {
 	All(ctx context.Context) ([]*Firewall, error)
 	// AllWithOpts returns all Firewalls for the given options.
 	AllWithOpts(ctx context.Context, opts FirewallListOpts) ([]*Firewall, error)
+	// Iter returns an iterator over all Firewalls for the given options.
+	// The pages are fetched lazily while iterating.
+	Iter(ctx context.Context, opts FirewallListOpts) iter.Seq2[*Firewall, error]
 	// Create creates a new Firewall.
 	Create(ctx context.Context, opts FirewallCreateOpts) (FirewallCreateResult, *Response, error)
 	// Update updates a Firewall.
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IImageClient ...
@@ -39,10 +40,19 @@ type IImageClient interface {
 	All(ctx context.Context) ([]*Image, error)
 	// AllWithOpts returns all images for the given options.
 	AllWithOpts(ctx context.Context, opts ImageListOpts) ([]*Image, error)
+	// Iter returns an iterator over all images for the given options.
+	// The pages are fetched lazily while iterating.
+	Iter(ctx context.Context, opts ImageListOpts) iter.Seq2[*Image, error]
 	// Delete deletes an image.
 	Delete(ctx context.Context, image *Image) (*Response, error)
 	// Update updates an image.
 	Update(ctx context.Context, image *Image, opts ImageUpdateOpts) (*Image, *Response, error)
 	// ChangeProtection changes the resource protection level of an image.
 	ChangeProtection(ctx context.Context, image *Image, opts ImageChangeProtectionOpts) (*Action, *Response, error)
+	// WaitUntil fetches the image at the interval defined by [WithPollOpts], until the
+	// condition holds (e.g. [ImageIsAvailable]), and returns the latest state of the image.
+	//
+	// The function stops waiting when the condition returns an error, when the image does
+	// not exist, or when the context is done.
+	WaitUntil(ctx context.Context, image *Image, condition func(*Image) (bool, error)) (*Image, error)
 }
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // INetworkClient ...
@@ -24,6 +25,9 @@ type INetworkClient interface {
 	All(ctx context.Context) ([]*Network, error)
 	// AllWithOpts returns all networks for the given options.
 	AllWithOpts(ctx context.Context, opts NetworkListOpts) ([]*Network, error)
+	// Iter returns an iterator over all networks for the given options.
+	// The pages are fetched lazily while iterating.
+	Iter(ctx context.Context, opts NetworkListOpts) iter.Seq2[*Network, error]
 	// Delete deletes a network.
 	Delete(ctx context.Context, network *Network) (*Response, error)
 	// Update updates a network.
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IServerClient ...
@@ -24,7 +25,12 @@ type IServerClient interface {
 	All(ctx context.Context) ([]*Server, error)
 	// AllWithOpts returns all servers for the given options.
 	AllWithOpts(ctx context.Context, opts ServerListOpts) ([]*Server, error)
+	// Iter returns an iterator over all servers for the given options.
+	// The pages are fetched lazily while iterating.
+	Iter(ctx context.Context, opts ServerListOpts) iter.Seq2[*Server, error]
 	// Create creates a new server.
+	//
+	// See [WithIdempotentCreate] to prevent creating duplicate servers when retrying.
 	Create(ctx context.Context, opts ServerCreateOpts) (ServerCreateResult, *Response, error)
 	// Delete deletes a server.
 	//
@@ -86,4 +92,10 @@ type IServerClient interface {
 	GetMetrics(ctx context.Context, server *Server, opts ServerGetMetricsOpts) (*ServerMetrics, *Response, error)
 	AddToPlacementGroup(ctx context.Context, server *Server, placementGroup *PlacementGroup) (*Action, *Response, error)
 	RemoveFromPlacementGroup(ctx context.Context, server *Server) (*Action, *Response, error)
+	// WaitUntil fetches the server at the interval defined by [WithPollOpts], until the
+	// condition holds (e.g. [ServerIsRunning]), and returns the latest state of the server.
+	//
+	// The function stops waiting when the condition returns an error, when the server does
+	// not exist, or when the context is done.
+	WaitUntil(ctx context.Context, server *Server, condition func(*Server) (bool, error)) (*Server, error)
 }
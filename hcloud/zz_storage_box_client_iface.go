@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IStorageBoxClient ...
@@ -37,12 +38,19 @@ type IStorageBoxClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-boxes-list-storage-boxes
 	AllWithOpts(ctx context.Context, opts StorageBoxListOpts) ([]*StorageBox, error)
+	// Iter returns an iterator over all [StorageBox] with the given options.
+	// The pages are fetched lazily while iterating.
+	//
+	// See https://docs.hetzner.cloud/reference/hetzner#storage-boxes-list-storage-boxes
+	Iter(ctx context.Context, opts StorageBoxListOpts) iter.Seq2[*StorageBox, error]
 	// Create creates a new [StorageBox] with the given options.
 	//
 	// To provide SSH keys, populate the PublicKey field for each [SSHKey]
 	// in the SSHKeys slice of [StorageBoxCreateOpts]. Only the PublicKey field
 	// is sent to the API. They are not addressable by ID or name.
 	//
+	// See [WithIdempotentCreate] to prevent creating duplicate storage boxes when retrying.
+	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-boxes-create-a-storage-box
 	Create(ctx context.Context, opts StorageBoxCreateOpts) (StorageBoxCreateResult, *Response, error)
 	// Update updates a [StorageBox] with the given options.
@@ -85,6 +93,13 @@ type IStorageBoxClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-actions-disable-snapshot-plan
 	DisableSnapshotPlan(ctx context.Context, storageBox *StorageBox) (*Action, *Response, error)
+	// WaitUntil fetches the [StorageBox] at the interval defined by [WithPollOpts], until
+	// the condition holds (e.g. [StorageBoxIsInitialized]), and returns the latest state of
+	// the [StorageBox].
+	//
+	// The function stops waiting when the condition returns an error, when the [StorageBox]
+	// does not exist, or when the context is done.
+	WaitUntil(ctx context.Context, storageBox *StorageBox, condition func(*StorageBox) (bool, error)) (*StorageBox, error)
 	// GetSnapshotByID gets a [StorageBoxSnapshot] by its ID.
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-get-a-snapshot
@@ -113,6 +128,11 @@ type IStorageBoxClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-list-snapshots
 	AllSnapshots(ctx context.Context, storageBox *StorageBox) ([]*StorageBoxSnapshot, error)
+	// IterSnapshots returns an iterator over all [StorageBoxSnapshot] of a [StorageBox] with
+	// the given options.
+	//
+	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-list-snapshots
+	IterSnapshots(ctx context.Context, storageBox *StorageBox, opts StorageBoxSnapshotListOpts) iter.Seq2[*StorageBoxSnapshot, error]
 	// CreateSnapshot creates a new [StorageBoxSnapshot] for the given [StorageBox] with the provided options.
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-snapshots-create-a-snapshot
@@ -155,6 +175,11 @@ type IStorageBoxClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccounts-list-subaccounts
 	AllSubaccounts(ctx context.Context, storageBox *StorageBox) ([]*StorageBoxSubaccount, error)
+	// IterSubaccounts returns an iterator over all [StorageBoxSubaccount] of a [StorageBox]
+	// with the given options.
+	//
+	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccounts-list-subaccounts
+	IterSubaccounts(ctx context.Context, storageBox *StorageBox, opts StorageBoxSubaccountListOpts) iter.Seq2[*StorageBoxSubaccount, error]
 	// CreateSubaccount creates a new [StorageBoxSubaccount] for a [StorageBox].
 	//
 	// See https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccounts-create-a-subaccount
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IVolumeClient ...
@@ -24,7 +25,12 @@ type IVolumeClient interface {
 	All(ctx context.Context) ([]*Volume, error)
 	// AllWithOpts returns all volumes with the given options.
 	AllWithOpts(ctx context.Context, opts VolumeListOpts) ([]*Volume, error)
+	// Iter returns an iterator over all volumes with the given options.
+	// The pages are fetched lazily while iterating.
+	Iter(ctx context.Context, opts VolumeListOpts) iter.Seq2[*Volume, error]
 	// Create creates a new volume with the given options.
+	//
+	// See [WithIdempotentCreate] to prevent creating duplicate volumes when retrying.
 	Create(ctx context.Context, opts VolumeCreateOpts) (VolumeCreateResult, *Response, error)
 	// Delete deletes a volume.
 	Delete(ctx context.Context, volume *Volume) (*Response, error)
@@ -4,6 +4,7 @@ package hcloud
 
 import (
 	"context"
+	"iter"
 )
 
 // IZoneClient ...
@@ -32,6 +33,11 @@ type IZoneClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zones-list-zones
 	AllWithOpts(ctx context.Context, opts ZoneListOpts) ([]*Zone, error)
+	// Iter returns an iterator over all [Zone] with the given options.
+	// The pages are fetched lazily while iterating.
+	//
+	// See https://docs.hetzner.cloud/reference/cloud#zones-list-zones
+	Iter(ctx context.Context, opts ZoneListOpts) iter.Seq2[*Zone, error]
 	// Create creates a new [Zone] from the given options.
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zones-create-a-zone
@@ -64,6 +70,12 @@ type IZoneClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zone-actions-change-a-zones-primary-nameservers
 	ChangePrimaryNameservers(ctx context.Context, zone *Zone, opts ZoneChangePrimaryNameserversOpts) (*Action, *Response, error)
+	// WaitUntil fetches the zone at the interval defined by [WithPollOpts], until the
+	// condition holds (e.g. [ZoneIsDelegated]), and returns the latest state of the zone.
+	//
+	// The function stops waiting when the condition returns an error, when the zone does not
+	// exist, or when the context is done.
+	WaitUntil(ctx context.Context, zone *Zone, condition func(*Zone) (bool, error)) (*Zone, error)
 	// GetRRSetByNameAndType returns a single [ZoneRRSet].
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-get-an-rrset
@@ -84,6 +96,11 @@ type IZoneClient interface {
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-list-rrsets
 	AllRRSets(ctx context.Context, zone *Zone) ([]*ZoneRRSet, error)
+	// IterRRSets returns an iterator over all [ZoneRRSet] with the given options.
+	// The pages are fetched lazily while iterating.
+	//
+	// See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-list-rrsets
+	IterRRSets(ctx context.Context, zone *Zone, opts ZoneRRSetListOpts) iter.Seq2[*ZoneRRSet, error]
 	// CreateRRSet creates a new [ZoneRRSet] from the given options.
 	//
 	// See https://docs.hetzner.cloud/reference/cloud#zone-rrsets-create-an-rrset